@@ -36,7 +36,7 @@ resource "uptrace_monitor" "error_monitor" {
 
   notify_everyone_by_email = false
 
-  params = {
+  error_params = {
     metrics = [
       {
         name  = "uptrace_tracing_events"
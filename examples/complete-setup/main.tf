@@ -72,7 +72,7 @@ resource "uptrace_monitor" "critical_error_rate" {
     # uptrace_notification_channel.telegram_oncall.id,
   ]
 
-  params = {
+  error_params = {
     metrics = [
       {
         name  = "uptrace_tracing_events"
@@ -80,16 +80,6 @@ resource "uptrace_monitor" "critical_error_rate" {
       }
     ]
     query = "sum($errors) | where span.event_name exists"
-
-    # Alert if error count > 100 in 5 minutes
-    min_allowed_value = 0
-    max_allowed_value = 100
-
-    # Check every 5 minutes
-    check_num_point = 1
-
-    # Notification settings
-    notify_everyone_by_email = false
   }
 }
 
@@ -102,7 +92,7 @@ resource "uptrace_monitor" "warning_4xx_errors" {
     uptrace_notification_channel.slack_warnings.id,
   ]
 
-  params = {
+  error_params = {
     metrics = [
       {
         name  = "uptrace_tracing_events"
@@ -110,27 +100,21 @@ resource "uptrace_monitor" "warning_4xx_errors" {
       }
     ]
     query = "sum($client_errors) | where span.event_name exists"
-
-    min_allowed_value = 0
-    max_allowed_value = 500
-
-    check_num_point = 1
-
-    notify_everyone_by_email = false
   }
 }
 
 # Critical: Database errors
 resource "uptrace_monitor" "critical_database_errors" {
-  name = "Critical: Database Errors"
-  type = "error"
+  name                     = "Critical: Database Errors"
+  type                     = "error"
+  notify_everyone_by_email = true
 
   channel_ids = [
     uptrace_notification_channel.slack_critical.id,
     uptrace_notification_channel.webhook_pagerduty.id,
   ]
 
-  params = {
+  error_params = {
     metrics = [
       {
         name  = "uptrace_tracing_events"
@@ -138,13 +122,6 @@ resource "uptrace_monitor" "critical_database_errors" {
       }
     ]
     query = "sum($db_errors) | where span.event_name exists"
-
-    min_allowed_value = 0
-    max_allowed_value = 10
-
-    check_num_point = 1
-
-    notify_everyone_by_email = true
   }
 }
 
@@ -161,7 +138,7 @@ resource "uptrace_monitor" "critical_api_latency" {
     uptrace_notification_channel.slack_critical.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.duration"
@@ -170,13 +147,12 @@ resource "uptrace_monitor" "critical_api_latency" {
     ]
 
     query = "p95($latency) > 2000000000"
+    column = "p95($latency)"
 
     # Alert if P95 latency > 2 seconds
     max_allowed_value = 2000000000 # 2 seconds in nanoseconds
 
     check_num_point = 2 # Alert if true for 2 consecutive checks
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -189,7 +165,7 @@ resource "uptrace_monitor" "warning_db_slow_queries" {
     uptrace_notification_channel.slack_warnings.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.duration"
@@ -198,12 +174,11 @@ resource "uptrace_monitor" "warning_db_slow_queries" {
     ]
 
     query = "p95($duration) > 500000000"
+    column = "p95($duration)"
 
     max_allowed_value = 500000000 # 500ms in nanoseconds
 
     check_num_point = 3
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -220,7 +195,7 @@ resource "uptrace_monitor" "warning_traffic_spike" {
     uptrace_notification_channel.slack_warnings.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.count"
@@ -229,27 +204,27 @@ resource "uptrace_monitor" "warning_traffic_spike" {
     ]
 
     query = "sum($count) > 10000"
+    column = "sum($count)"
 
     # Alert if request count > 10000/minute
     max_allowed_value = 10000
 
     check_num_point = 2
-
-    notify_everyone_by_email = false
   }
 }
 
 # Critical: Traffic dropped to zero
 resource "uptrace_monitor" "critical_no_traffic" {
-  name = "Critical: No Traffic Detected"
-  type = "metric"
+  name                     = "Critical: No Traffic Detected"
+  type                     = "metric"
+  notify_everyone_by_email = true
 
   channel_ids = [
     uptrace_notification_channel.slack_critical.id,
     uptrace_notification_channel.webhook_pagerduty.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.count"
@@ -258,13 +233,12 @@ resource "uptrace_monitor" "critical_no_traffic" {
     ]
 
     query = "sum($count) < 10"
+    column = "sum($count)"
 
     # Alert if request count < 10/minute
     min_allowed_value = 10
 
     check_num_point = 3
-
-    notify_everyone_by_email = true
   }
 }
 
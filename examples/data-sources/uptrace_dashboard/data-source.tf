@@ -0,0 +1,23 @@
+# Read an existing dashboard by ID
+data "uptrace_dashboard" "by_id" {
+  id = "123"
+}
+
+# Read an existing dashboard by its exact name
+data "uptrace_dashboard" "overview" {
+  name = "Service Overview"
+}
+
+output "overview_dashboard_id" {
+  description = "The ID of the Service Overview dashboard"
+  value       = data.uptrace_dashboard.overview.id
+}
+
+# Copy a dashboard created in the UI into a Terraform-managed one
+resource "uptrace_dashboard" "copy" {
+  yaml = replace(
+    data.uptrace_dashboard.overview.yaml,
+    "name: Service Overview",
+    "name: Service Overview (copy)",
+  )
+}
@@ -0,0 +1,37 @@
+# List all dashboards in the project
+data "uptrace_dashboards" "all" {}
+
+output "all_dashboard_names" {
+  description = "Names of all dashboards"
+  value       = [for d in data.uptrace_dashboards.all.dashboards : d.name]
+}
+
+# Filter dashboards by pinned status
+data "uptrace_dashboards" "pinned" {
+  pinned = true
+}
+
+output "pinned_dashboard_ids" {
+  description = "IDs of pinned dashboards"
+  value       = [for d in data.uptrace_dashboards.pinned.dashboards : d.id]
+}
+
+# Filter dashboards by name (substring match)
+data "uptrace_dashboards" "postgres" {
+  name = "PostgreSQL"
+}
+
+# Filter dashboards by the template they were created from
+data "uptrace_dashboards" "from_template" {
+  template_id = "uptrace.db.postgresql_by_host"
+}
+
+output "template_dashboards" {
+  description = "Dashboards created from the PostgreSQL template"
+  value = [
+    for d in data.uptrace_dashboards.from_template.dashboards : {
+      id   = d.id
+      name = d.name
+    }
+  ]
+}
@@ -15,7 +15,7 @@ output "monitor_state" {
 }
 
 output "monitor_type" {
-  description = "Monitor type (metric or error)"
+  description = "Monitor type (metric, error, span, log or uptime)"
   value       = data.uptrace_monitor.existing.type
 }
 
@@ -24,12 +24,13 @@ resource "uptrace_monitor" "example" {
   name = "Example CPU Monitor"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 90"
+    column            = "avg($cpu)"
     max_allowed_value = 90
     check_num_point   = 2
   }
@@ -43,7 +44,7 @@ data "uptrace_monitor" "example" {
 # Access monitor details
 output "example_monitor_params" {
   description = "Monitor parameters"
-  value       = data.uptrace_monitor.example.params
+  value       = data.uptrace_monitor.example.metric_params
 }
 
 output "example_created_at" {
@@ -0,0 +1,31 @@
+# Refuse to deploy while the checkout latency monitor is firing
+data "uptrace_monitor_alerts" "checkout" {
+  monitor_id = uptrace_monitor.checkout_latency.id
+
+  lifecycle {
+    postcondition {
+      condition     = self.firing_count == 0
+      error_message = "The checkout latency monitor is firing, refusing to deploy."
+    }
+  }
+}
+
+# List every open or firing alert in the project
+data "uptrace_monitor_alerts" "all" {}
+
+output "active_alerts" {
+  description = "Open and firing alerts with the affected service"
+  value = [
+    for a in data.uptrace_monitor_alerts.all.alerts : {
+      name    = a.name
+      state   = a.state
+      service = lookup(a.attributes, "service_name", null)
+    }
+  ]
+}
+
+# Recently resolved alerts of a monitor
+data "uptrace_monitor_alerts" "resolved" {
+  monitor_id = uptrace_monitor.checkout_latency.id
+  states     = ["closed"]
+}
@@ -103,12 +103,13 @@ resource "uptrace_monitor" "example" {
   name = "Example Monitor"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 90"
+    column            = "avg($cpu)"
     max_allowed_value = 90
   }
 }
@@ -0,0 +1,26 @@
+# Look up a channel owned by another team by its exact name
+data "uptrace_notification_channel" "oncall" {
+  name = "Platform On-Call Slack"
+}
+
+# Or read it by ID
+data "uptrace_notification_channel" "by_id" {
+  id = "42"
+}
+
+# Route monitor alerts to the shared channel without hardcoding its ID
+resource "uptrace_monitor" "api_errors" {
+  name = "API Errors"
+  type = "error"
+
+  error_params = {
+    query = "service_name = 'api'"
+  }
+
+  channel_ids = [tonumber(data.uptrace_notification_channel.oncall.id)]
+}
+
+output "oncall_monitor_count" {
+  description = "Number of monitors using the on-call channel"
+  value       = data.uptrace_notification_channel.oncall.monitor_count
+}
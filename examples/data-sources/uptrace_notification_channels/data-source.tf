@@ -0,0 +1,44 @@
+# List all notification channels in the project
+data "uptrace_notification_channels" "all" {}
+
+output "all_channel_names" {
+  description = "Names of all notification channels"
+  value       = [for c in data.uptrace_notification_channels.all.channels : c.name]
+}
+
+# Filter channels by type
+data "uptrace_notification_channels" "slack" {
+  type = "slack"
+}
+
+# Filter channels that receive high priority alerts
+data "uptrace_notification_channels" "high_priority" {
+  priority = "High"
+}
+
+# Send alerts to every Slack channel
+resource "uptrace_monitor" "cpu" {
+  name = "High CPU"
+  type = "metric"
+
+  metric_params = {
+    metrics = [{
+      name  = "system.cpu.utilization"
+      alias = "$cpu"
+    }]
+    query             = "avg($cpu)"
+    column            = "avg($cpu)"
+    max_allowed_value = 90
+  }
+
+  channel_ids = [for c in data.uptrace_notification_channels.slack.channels : tonumber(c.id)]
+}
+
+# Find channels that are not used by any monitor
+output "unused_channels" {
+  description = "Channels without monitors"
+  value = [
+    for c in data.uptrace_notification_channels.all.channels :
+    c.name if c.monitor_count == 0
+  ]
+}
@@ -46,15 +46,16 @@ resource "uptrace_notification_channel" "sev3_slack" {
 
 # SEV1: Critical - Page immediately
 resource "uptrace_monitor" "sev1_complete_outage" {
-  name = "SEV1: Complete Service Outage"
-  type = "metric"
+  name                     = "SEV1: Complete Service Outage"
+  type                     = "metric"
+  notify_everyone_by_email = true
 
   channel_ids = [
     uptrace_notification_channel.sev1_pagerduty.id,
     uptrace_notification_channel.sev2_slack.id, # Also notify Slack
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.count"
@@ -71,8 +72,6 @@ resource "uptrace_monitor" "sev1_complete_outage" {
     check_num_point = 3
 
     column = "$requests"
-
-    notify_everyone_by_email = true
   }
 }
 
@@ -85,15 +84,9 @@ resource "uptrace_monitor" "sev2_high_error_rate" {
     uptrace_notification_channel.sev2_slack.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "span.status_code:error"
-
-    max_allowed_value = 100
-
-    check_num_point = 2
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -106,7 +99,7 @@ resource "uptrace_monitor" "sev3_elevated_latency" {
     uptrace_notification_channel.sev3_slack.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.duration"
@@ -121,8 +114,6 @@ resource "uptrace_monitor" "sev3_elevated_latency" {
     check_num_point = 3
 
     column = "$latency"
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -167,15 +158,9 @@ resource "uptrace_monitor" "backend_api_errors" {
     uptrace_notification_channel.team_backend.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "service.name:api-server span.status_code:error"
-
-    max_allowed_value = 50
-
-    check_num_point = 2
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -188,15 +173,9 @@ resource "uptrace_monitor" "frontend_client_errors" {
     uptrace_notification_channel.team_frontend.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "service.name:web-app span.status_code:error"
-
-    max_allowed_value = 100
-
-    check_num_point = 2
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -209,15 +188,9 @@ resource "uptrace_monitor" "data_pipeline_errors" {
     uptrace_notification_channel.team_data.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "service.name:data-pipeline span.status_code:error"
-
-    max_allowed_value = 10
-
-    check_num_point = 1
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -256,8 +229,9 @@ resource "uptrace_notification_channel" "fanout_webhook" {
 
 # Critical alert goes to all channels
 resource "uptrace_monitor" "critical_payment_failures" {
-  name = "CRITICAL: Payment Processing Failures"
-  type = "error"
+  name                     = "CRITICAL: Payment Processing Failures"
+  type                     = "error"
+  notify_everyone_by_email = true
 
   # Fan out to ALL channels
   channel_ids = [
@@ -267,15 +241,9 @@ resource "uptrace_monitor" "critical_payment_failures" {
     uptrace_notification_channel.sev1_pagerduty.id, # Also page
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "service.name:payment-service span.status_code:error"
-
-    max_allowed_value = 5
-
-    check_num_point = 1
-
-    notify_everyone_by_email = true
   }
 }
 
@@ -311,15 +279,9 @@ resource "uptrace_monitor" "prod_error_rate" {
     uptrace_notification_channel.prod_alerts.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "deployment.environment:production span.status_code:error"
-
-    max_allowed_value = 50
-
-    check_num_point = 2
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -332,15 +294,9 @@ resource "uptrace_monitor" "staging_error_rate" {
     uptrace_notification_channel.staging_alerts.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "deployment.environment:staging span.status_code:error"
-
-    max_allowed_value = 200
-
-    check_num_point = 5
-
-    notify_everyone_by_email = false
   }
 }
 
@@ -376,7 +332,7 @@ resource "uptrace_monitor" "degraded_performance" {
     uptrace_notification_channel.business_hours.id,
   ]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "span.duration"
@@ -391,29 +347,22 @@ resource "uptrace_monitor" "degraded_performance" {
     check_num_point = 5
 
     column = "$latency"
-
-    notify_everyone_by_email = false
   }
 }
 
 # After hours: Critical issues page on-call
 resource "uptrace_monitor" "after_hours_outage" {
-  name = "Critical Outage (Page On-Call)"
-  type = "error"
+  name                     = "Critical Outage (Page On-Call)"
+  type                     = "error"
+  notify_everyone_by_email = true
 
   channel_ids = [
     uptrace_notification_channel.after_hours.id,
     uptrace_notification_channel.business_hours.id, # Also post to Slack
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "span.status_code:error"
-
-    max_allowed_value = 100
-
-    check_num_point = 2
-
-    notify_everyone_by_email = true
   }
 }
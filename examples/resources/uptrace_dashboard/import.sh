@@ -1,2 +1,8 @@
 # Dashboard can be imported by specifying the dashboard ID
 terraform import uptrace_dashboard.example 123
+
+# Prefix the ID with a project ID to import from another project
+terraform import uptrace_dashboard.example 2/123
+
+# Dashboards can also be imported by their exact name
+terraform import uptrace_dashboard.example "name:Service Overview"
@@ -1,4 +1,6 @@
 resource "uptrace_dashboard" "example" {
+  pinned = true
+
   yaml = <<-YAML
     schema: v2
     name: Service Overview
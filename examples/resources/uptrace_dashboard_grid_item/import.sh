@@ -0,0 +1,5 @@
+# Grid items can be imported by specifying the dashboard ID and the item ID
+terraform import uptrace_dashboard_grid_item.requests 123/789
+
+# Prefix the IDs with a project ID to import from another project
+terraform import uptrace_dashboard_grid_item.requests 2/123/789
@@ -0,0 +1,83 @@
+# Chart placed in a grid row
+resource "uptrace_dashboard_grid_item" "requests" {
+  dashboard_id = uptrace_dashboard.example.id
+  row_id       = uptrace_dashboard_grid_row.traffic.id
+  title        = "Requests per minute"
+  width        = 12
+  height       = 14
+
+  chart = {
+    chart_kind = "stacked-bar"
+    metrics = [
+      { name = "uptrace_tracing_spans", alias = "spans" },
+    ]
+    query = "per_min(sum($spans)) | group by service_name"
+
+    legend = {
+      type      = "table"
+      placement = "right"
+      values    = ["avg", "max"]
+    }
+  }
+}
+
+# Table in the dashboard's table section (no row)
+resource "uptrace_dashboard_grid_item" "top_services" {
+  dashboard_id = uptrace_dashboard.example.id
+  title        = "Top services"
+
+  table = {
+    metrics = [
+      { name = "uptrace_tracing_spans", alias = "spans" },
+    ]
+    query = "group by service_name | count($spans) | p99($spans)"
+
+    column_map = {
+      "count($spans)" = {
+        unit     = "short"
+        agg_func = "sum"
+      }
+    }
+  }
+}
+
+# Heatmap of span durations
+resource "uptrace_dashboard_grid_item" "latency" {
+  dashboard_id = uptrace_dashboard.example.id
+  row_id       = uptrace_dashboard_grid_row.traffic.id
+  title        = "Latency distribution"
+  width        = 24
+
+  heatmap = {
+    metric = "uptrace_tracing_spans"
+    query  = "_duration"
+    unit   = "microseconds"
+  }
+}
+
+# Gauge with value mappings
+resource "uptrace_dashboard_grid_item" "error_rate" {
+  dashboard_id = uptrace_dashboard.example.id
+  row_id       = uptrace_dashboard_grid_row.traffic.id
+  title        = "Error rate"
+  width        = 6
+
+  gauge = {
+    metrics = [
+      { name = "uptrace_tracing_spans", alias = "spans" },
+    ]
+    query = "count($spans{_status_code='error'}) / count($spans) as error_rate"
+
+    column_map = {
+      error_rate = {
+        unit     = "utilization"
+        agg_func = "last"
+      }
+    }
+
+    value_mappings = [
+      { op = "gt", value = 0.05, color = "#d32f2f", text = "Critical" },
+      { op = "any", color = "#388e3c" },
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+# Grid rows can be imported by specifying the dashboard ID and the row ID
+terraform import uptrace_dashboard_grid_row.traffic 123/456
+
+# Prefix the IDs with a project ID to import from another project
+terraform import uptrace_dashboard_grid_row.traffic 2/123/456
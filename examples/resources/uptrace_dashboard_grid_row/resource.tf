@@ -0,0 +1,7 @@
+resource "uptrace_dashboard_grid_row" "traffic" {
+  dashboard_id = uptrace_dashboard.example.id
+  title        = "Traffic"
+  description  = "Request and error rates owned by the API team"
+  expanded     = true
+  index        = 0
+}
@@ -0,0 +1,26 @@
+# Silence the checkout monitors during the weekly database upgrade
+resource "uptrace_maintenance_window" "db_upgrade" {
+  name = "Weekly database upgrade"
+
+  monitor_ids = [
+    uptrace_monitor.slow_checkout.id,
+    uptrace_monitor.api_errors.id,
+  ]
+
+  start_time = "2026-03-07T02:00:00+01:00"
+  end_time   = "2026-03-07T04:00:00+01:00"
+  recurrence = "0 2 * * sat"
+}
+
+# Silence every uptime check with "staging" in its name for a one-off migration
+resource "uptrace_maintenance_window" "staging_migration" {
+  name = "Staging migration"
+
+  monitor_filter = {
+    type = "uptime"
+    name = "staging"
+  }
+
+  start_time = "2026-03-10T18:00:00Z"
+  end_time   = "2026-03-10T20:00:00Z"
+}
@@ -10,7 +10,7 @@ resource "uptrace_monitor" "cloud_latency" {
   # Valid values: avg, sum, min, max, p50, p90, p95, p99
   trend_agg_func = "avg"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "span.duration"
       alias = "$latency"
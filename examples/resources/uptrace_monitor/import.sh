@@ -1,3 +1,10 @@
 #!/bin/sh
 # Import an existing Uptrace monitor by ID
 terraform import uptrace_monitor.high_cpu <monitor_id>
+
+# Import a monitor from a project other than the provider default
+terraform import uptrace_monitor.high_cpu <project_id>/<monitor_id>
+
+# Import by exact monitor name, optionally prefixed with a project ID
+terraform import uptrace_monitor.high_cpu "name:High CPU Usage"
+terraform import uptrace_monitor.high_cpu "<project_id>/name:High CPU Usage"
@@ -6,7 +6,7 @@ resource "uptrace_monitor" "high_cpu" {
   notify_everyone_by_email = false
   channel_ids              = [1, 2]
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "system.cpu.utilization"
@@ -15,9 +15,15 @@ resource "uptrace_monitor" "high_cpu" {
     ]
     query              = "avg(cpu_usage) > 90"
     column             = "cpu_usage"
-    max_allowed_value  = 90
-    grouping_interval  = 60000
-    check_num_point    = 3
+    grouping_interval  = "1m"
+
+    # Alert once the CPU usage stays above 90 for 3 consecutive points
+    condition = {
+      operator   = "above"
+      value      = 90
+      for_points = 3
+    }
+
     nulls_mode         = "allow"
   }
 }
@@ -30,7 +36,7 @@ resource "uptrace_monitor" "api_errors" {
   notify_everyone_by_email = true
   team_ids                 = [1]
 
-  params = {
+  error_params = {
     metrics = [
       {
         name = "uptrace_tracing_logs"
@@ -39,3 +45,53 @@ resource "uptrace_monitor" "api_errors" {
     query = "severity:ERROR AND service:api"
   }
 }
+
+# Span monitor example
+resource "uptrace_monitor" "slow_checkout" {
+  name = "Slow Checkout Requests"
+  type = "span"
+
+  channel_ids = [1]
+
+  span_params = {
+    query             = "p99(_duration) | where service_name = 'checkout' | group by host_name"
+    column            = "p99(_duration)"
+    max_allowed_value = 2000000000
+    check_num_point   = 3
+  }
+}
+
+# Log monitor example
+resource "uptrace_monitor" "error_logs" {
+  name = "Error Log Spike"
+  type = "log"
+
+  # Not evaluated until paused is set back to false
+  paused = true
+
+  log_params = {
+    query             = "count() | where log_severity = 'ERROR' | group by service_name"
+    column            = "count()"
+    max_allowed_value = 100
+  }
+}
+
+# Uptime monitor example
+resource "uptrace_monitor" "health_check" {
+  name = "API Health Check"
+  type = "uptime"
+
+  notify_everyone_by_email = true
+
+  uptime_params = {
+    url                   = "https://api.example.com/health"
+    method                = "GET"
+    expected_status_codes = [200, 204]
+    check_interval        = 30
+    timeout               = 5
+
+    headers = {
+      Authorization = "Bearer ${var.health_check_token}"
+    }
+  }
+}
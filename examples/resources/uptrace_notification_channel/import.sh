@@ -1,3 +1,9 @@
 #!/bin/sh
 # Import an existing Uptrace notification channel by ID
 terraform import uptrace_notification_channel.example <channel_id>
+
+# Import a notification channel from a project other than the provider default
+terraform import uptrace_notification_channel.example <project_id>/<channel_id>
+
+# Import by exact channel name
+terraform import uptrace_notification_channel.example "name:Engineering Alerts"
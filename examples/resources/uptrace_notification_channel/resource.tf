@@ -3,18 +3,39 @@ resource "uptrace_notification_channel" "slack" {
   name = "Engineering Alerts"
   type = "slack"
 
-  params = {
-    webhookUrl = var.slack_webhook_url
+  slack = {
+    webhook_url = var.slack_webhook_url
+  }
+}
+
+# Slack channel whose webhook URL never reaches the state file (Terraform 1.11+)
+resource "uptrace_notification_channel" "slack_write_only" {
+  name = "Security Alerts"
+  type = "slack"
+
+  slack = {
+    webhook_url_wo = var.security_slack_webhook_url
   }
+
+  # Increment to push a rotated webhook URL to Uptrace
+  secrets_wo_version = 1
 }
 
-# Webhook notification channel
+# Webhook notification channel with a custom JSON payload
 resource "uptrace_notification_channel" "webhook" {
   name = "Custom Webhook"
   type = "webhook"
 
-  params = {
+  webhook = {
     url = "https://example.com/webhook"
+    payload = jsonencode({
+      source  = "uptrace"
+      urgent  = true
+      retries = 3
+    })
+    headers = {
+      Authorization = "Bearer ${var.webhook_token}"
+    }
   }
 }
 
@@ -23,9 +44,9 @@ resource "uptrace_notification_channel" "telegram" {
   name = "Telegram Alerts"
   type = "telegram"
 
-  params = {
-    botToken = var.telegram_bot_token
-    chatId   = "-1001234567890"
+  telegram = {
+    bot_token = var.telegram_bot_token
+    chat_id   = "-1001234567890"
   }
 }
 
@@ -34,8 +55,18 @@ resource "uptrace_notification_channel" "mattermost" {
   name = "Mattermost Alerts"
   type = "mattermost"
 
+  mattermost = {
+    webhook_url = var.mattermost_webhook_url
+  }
+}
+
+# Raw params remain available for channel types without a typed block
+resource "uptrace_notification_channel" "raw" {
+  name = "Raw Webhook"
+  type = "webhook"
+
   params = {
-    webhookUrl = var.mattermost_webhook_url
+    url = "https://example.com/raw-webhook"
   }
 }
 
@@ -49,8 +80,8 @@ resource "uptrace_notification_channel" "mattermost" {
 #   type      = "slack"
 #   condition = "your_condition_here"
 #
-#   params = {
-#     webhookUrl = var.slack_webhook_url
+#   slack = {
+#     webhook_url = var.slack_webhook_url
 #   }
 # }
 
@@ -64,8 +95,8 @@ resource "uptrace_monitor" "high_error_rate" {
     uptrace_notification_channel.telegram.id,
   ]
 
-  params = {
-    metrics = []
+  error_params = {
+    metrics = [{ name = "uptrace_tracing_events" }]
     query   = "error"
   }
 }
@@ -6,16 +6,25 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
 )
 
 // Client wraps the generated Uptrace API client with higher-level operations.
 type Client struct {
-	client    *generated.ClientWithResponses
+	*connection
 	projectID int64
 }
 
+// connection holds the state shared by a client and every client derived from it with ForProject:
+// the generated API client with its retry, rate limiting and timeout transport chain, and the rate limiter,
+// so the request rate limit applies to all projects together.
+type connection struct {
+	client  *generated.ClientWithResponses
+	limiter *rateLimiter
+}
+
 // Config holds the configuration for creating a new Uptrace client.
 type Config struct {
 	// Endpoint is the base URL of the Uptrace API
@@ -26,6 +35,18 @@ type Config struct {
 	ProjectID int64
 	// HTTPClient is an optional custom HTTP client
 	HTTPClient *http.Client
+	// MaxRetries is the number of times a failed request is retried (0 disables retries)
+	MaxRetries int
+	// RequestTimeout limits each attempt of a request (0 means no timeout)
+	RequestTimeout time.Duration
+	// RetryWaitMin is the initial backoff between retries (defaults to 1s)
+	RetryWaitMin time.Duration
+	// RetryWaitMax is the maximum backoff between retries (defaults to 30s)
+	RetryWaitMax time.Duration
+	// RequestsPerSecond limits the request rate of the client (0 disables rate limiting)
+	RequestsPerSecond float64
+	// RateLimitBurst is the number of requests allowed at once (defaults to RequestsPerSecond rounded up)
+	RateLimitBurst int
 }
 
 // New creates a new Uptrace API client.
@@ -49,10 +70,25 @@ func New(cfg Config) (*Client, error) {
 		return nil
 	}
 
-	httpClient := cfg.HTTPClient
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+	// Wrap the transport with the per-request timeout, rate limiting and retries.
+	// The limiter sits below the retries so every attempt waits for a token, and
+	// above the timeout so waiting for a token does not count against it.
+	httpClient := &http.Client{}
+	if cfg.HTTPClient != nil {
+		*httpClient = *cfg.HTTPClient
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
 	}
+	if cfg.RequestTimeout > 0 {
+		transport = &timeoutTransport{next: transport, timeout: cfg.RequestTimeout}
+	}
+	limiter := newRateLimiter(cfg.RequestsPerSecond, cfg.RateLimitBurst)
+	if limiter != nil {
+		transport = &rateLimitTransport{next: transport, limiter: limiter}
+	}
+	httpClient.Transport = newRetryTransport(transport, cfg)
 
 	client, err := generated.NewClientWithResponses(
 		endpoint,
@@ -64,11 +100,37 @@ func New(cfg Config) (*Client, error) {
 	}
 
 	return &Client{
-		client:    client,
-		projectID: cfg.ProjectID,
+		connection: &connection{client: client, limiter: limiter},
+		projectID:  cfg.ProjectID,
 	}, nil
 }
 
+// RequestRate returns the current client-side request rate limit in requests per second.
+// It is lower than the configured rate while the API is responding with 429 Too Many Requests.
+// It returns 0 when rate limiting is disabled.
+func (c *Client) RequestRate() float64 {
+	if c.limiter == nil {
+		return 0
+	}
+	return c.limiter.Rate()
+}
+
+// ProjectID returns the project the client operates on.
+func (c *Client) ProjectID() int64 {
+	return c.projectID
+}
+
+// ForProject returns a client that operates on the given project.
+// The returned client shares its connection with c, so requests of both count against the same rate limit
+// and a 429 response to either slows down both.
+// A projectID of 0 or less returns c unchanged.
+func (c *Client) ForProject(projectID int64) *Client {
+	if projectID <= 0 || projectID == c.projectID {
+		return c
+	}
+	return &Client{connection: c.connection, projectID: projectID}
+}
+
 // isSuccessStatus checks if the status code matches any of the allowed success codes.
 func isSuccessStatus(statusCode int, allowed ...int) bool {
 	for _, code := range allowed {
@@ -177,6 +239,53 @@ func (c *Client) DeleteMonitor(ctx context.Context, monitorID string) error {
 	return nil
 }
 
+// PauseMonitor pauses a monitor so it stops evaluating and sending notifications.
+func (c *Client) PauseMonitor(ctx context.Context, monitorID string) error {
+	resp, err := c.client.PauseMonitorWithResponse(ctx, c.projectID, monitorID)
+	if err != nil {
+		return fmt.Errorf("failed to pause monitor: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// ActivateMonitor resumes a paused monitor.
+func (c *Client) ActivateMonitor(ctx context.Context, monitorID string) error {
+	resp, err := c.client.ActivateMonitorWithResponse(ctx, c.projectID, monitorID)
+	if err != nil {
+		return fmt.Errorf("failed to activate monitor: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// ListAlerts retrieves the alerts of the project. A nil MonitorId or State in params
+// returns alerts of every monitor or state.
+func (c *Client) ListAlerts(ctx context.Context, params generated.ListAlertsParams) ([]generated.Alert, error) {
+	resp, err := c.client.ListAlertsWithResponse(ctx, c.projectID, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return []generated.Alert{}, nil
+	}
+
+	return resp.JSON200.Alerts, nil
+}
+
 // ListDashboards retrieves all dashboards for the project.
 func (c *Client) ListDashboards(ctx context.Context) ([]generated.Dashboard, error) {
 	resp, err := c.client.ListDashboardsWithResponse(ctx, c.projectID)
@@ -367,6 +476,243 @@ func (c *Client) ResetDashboard(ctx context.Context, dashboardID int64) error {
 	return nil
 }
 
+// ListGridRows retrieves the grid rows of a dashboard, including their items.
+func (c *Client) ListGridRows(ctx context.Context, dashboardID int64) ([]generated.GridRow, error) {
+	layout, err := c.getDashboardLayout(ctx, dashboardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grid rows: %w", err)
+	}
+
+	if layout.GridRows == nil {
+		return []generated.GridRow{}, nil
+	}
+
+	return *layout.GridRows, nil
+}
+
+// GetGridRow retrieves a specific grid row of a dashboard.
+// The API has no dedicated endpoint for rows, so the row is looked up in the dashboard response.
+func (c *Client) GetGridRow(ctx context.Context, dashboardID, rowID int64) (*generated.GridRow, error) {
+	rows, err := c.ListGridRows(ctx, dashboardID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		if rows[i].Id == rowID {
+			return &rows[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("grid row %d does not exist in dashboard %d: %w", rowID, dashboardID, ErrNotFound)
+}
+
+// CreateGridRow creates a new grid row in a dashboard.
+func (c *Client) CreateGridRow(ctx context.Context, dashboardID int64, input generated.CreateGridRowJSONRequestBody) (*generated.GridRow, error) {
+	resp, err := c.client.CreateGridRowWithResponse(ctx, c.projectID, dashboardID, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grid row: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridRow, nil
+}
+
+// UpdateGridRow updates an existing grid row.
+//
+//nolint:gocritic // Generated API type passed by value to match oapi-codegen signature
+func (c *Client) UpdateGridRow(ctx context.Context, dashboardID, rowID int64, row generated.GridRow) (*generated.GridRow, error) {
+	resp, err := c.client.UpdateGridRowWithResponse(ctx, c.projectID, dashboardID, rowID, row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update grid row: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridRow, nil
+}
+
+// DeleteGridRow deletes a grid row and all its items.
+func (c *Client) DeleteGridRow(ctx context.Context, dashboardID, rowID int64) error {
+	resp, err := c.client.DeleteGridRowWithResponse(ctx, c.projectID, dashboardID, rowID)
+	if err != nil {
+		return fmt.Errorf("failed to delete grid row: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK, http.StatusNoContent) {
+		return c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// MoveGridRowUp moves a grid row one position up in the dashboard.
+//
+//nolint:dupl // Mirrors MoveGridRowDown with a different endpoint
+func (c *Client) MoveGridRowUp(ctx context.Context, dashboardID, rowID int64) (*generated.GridRow, error) {
+	resp, err := c.client.MoveGridRowUpWithResponse(ctx, c.projectID, dashboardID, rowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move grid row up: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridRow, nil
+}
+
+// MoveGridRowDown moves a grid row one position down in the dashboard.
+//
+//nolint:dupl // Mirrors MoveGridRowUp with a different endpoint
+func (c *Client) MoveGridRowDown(ctx context.Context, dashboardID, rowID int64) (*generated.GridRow, error) {
+	resp, err := c.client.MoveGridRowDownWithResponse(ctx, c.projectID, dashboardID, rowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move grid row down: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridRow, nil
+}
+
+// GetGridItem retrieves a specific grid item of a dashboard.
+// The API has no dedicated endpoint for items, so the item is looked up in the
+// dashboard's grid rows and table section.
+func (c *Client) GetGridItem(ctx context.Context, dashboardID, itemID int64) (*generated.GridItem, error) {
+	layout, err := c.getDashboardLayout(ctx, dashboardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grid item: %w", err)
+	}
+
+	if layout.GridRows != nil {
+		for _, row := range *layout.GridRows {
+			if row.Items == nil {
+				continue
+			}
+			for i := range *row.Items {
+				if (*row.Items)[i].Id == itemID {
+					return &(*row.Items)[i], nil
+				}
+			}
+		}
+	}
+
+	if layout.TableItems != nil {
+		for i := range *layout.TableItems {
+			if (*layout.TableItems)[i].Id == itemID {
+				return &(*layout.TableItems)[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("grid item %d does not exist in dashboard %d: %w", itemID, dashboardID, ErrNotFound)
+}
+
+// CreateGridItem creates a new grid item in a dashboard.
+//
+//nolint:gocritic // Generated API type passed by value to match oapi-codegen signature
+func (c *Client) CreateGridItem(ctx context.Context, dashboardID int64, item generated.GridItem) (*generated.GridItem, error) {
+	resp, err := c.client.CreateGridItemWithResponse(ctx, c.projectID, dashboardID, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grid item: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridItem, nil
+}
+
+// UpdateGridItem updates an existing grid item.
+//
+//nolint:gocritic // Generated API type passed by value to match oapi-codegen signature
+func (c *Client) UpdateGridItem(ctx context.Context, dashboardID, itemID int64, item generated.GridItem) (*generated.GridItem, error) {
+	resp, err := c.client.UpdateGridItemWithResponse(ctx, c.projectID, dashboardID, itemID, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update grid item: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response")
+	}
+
+	return &resp.JSON200.GridItem, nil
+}
+
+// DeleteGridItem deletes a grid item from a dashboard.
+func (c *Client) DeleteGridItem(ctx context.Context, dashboardID, itemID int64) error {
+	resp, err := c.client.DeleteGridItemWithResponse(ctx, c.projectID, dashboardID, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to delete grid item: %w", err)
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK, http.StatusNoContent) {
+		return c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+// dashboardLayout is the part of the getDashboard response describing grid rows and table items.
+type dashboardLayout struct {
+	GridRows   *[]generated.GridRow
+	TableItems *[]generated.GridItem
+}
+
+// getDashboardLayout fetches a dashboard and returns its grid rows and table items.
+func (c *Client) getDashboardLayout(ctx context.Context, dashboardID int64) (*dashboardLayout, error) {
+	resp, err := c.client.GetDashboardWithResponse(ctx, c.projectID, dashboardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSuccessStatus(resp.StatusCode(), http.StatusOK) {
+		return nil, c.handleErrorResponse(resp.StatusCode(), resp.Body)
+	}
+
+	if resp.JSON200 == nil {
+		return &dashboardLayout{}, nil
+	}
+
+	return &dashboardLayout{
+		GridRows:   resp.JSON200.GridRows,
+		TableItems: resp.JSON200.TableItems,
+	}, nil
+}
+
 // ListNotificationChannels retrieves all notification channels for the project.
 func (c *Client) ListNotificationChannels(ctx context.Context) ([]generated.NotificationChannel, error) {
 	resp, err := c.client.ListNotificationChannelsWithResponse(ctx, c.projectID)
@@ -457,20 +803,7 @@ func (c *Client) DeleteNotificationChannel(ctx context.Context, channelID int64)
 	return nil
 }
 
-// handleErrorResponse processes error responses from the API.
+// handleErrorResponse converts an error response into an *APIError.
 func (c *Client) handleErrorResponse(statusCode int, body []byte) error {
-	switch statusCode {
-	case http.StatusBadRequest:
-		return fmt.Errorf("bad request: %s", string(body))
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized: invalid or missing authentication token")
-	case http.StatusForbidden:
-		return fmt.Errorf("forbidden: insufficient permissions")
-	case http.StatusNotFound:
-		return fmt.Errorf("not found: resource does not exist")
-	case http.StatusInternalServerError:
-		return fmt.Errorf("internal server error: %s", string(body))
-	default:
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
-	}
+	return newAPIError(statusCode, body)
 }
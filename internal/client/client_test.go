@@ -134,6 +134,124 @@ func TestResetDashboard(t *testing.T) {
 	}
 }
 
+// TestPauseMonitor tests the PauseMonitor client method.
+func TestPauseMonitor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if r.URL.Path != "/projects/1/monitors/42/paused" {
+			t.Errorf("Expected path /projects/1/monitors/42/paused, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.PauseMonitor(context.Background(), "42"); err != nil {
+		t.Fatalf("PauseMonitor failed: %v", err)
+	}
+}
+
+// TestActivateMonitor tests the ActivateMonitor client method.
+func TestActivateMonitor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if r.URL.Path != "/projects/1/monitors/42/active" {
+			t.Errorf("Expected path /projects/1/monitors/42/active, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.ActivateMonitor(context.Background(), "42"); err != nil {
+		t.Fatalf("ActivateMonitor failed: %v", err)
+	}
+}
+
+// TestPauseMonitor_Error tests error handling in PauseMonitor.
+func TestPauseMonitor_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"code": "not_found", "message": "Monitor not found"}, "statusCode": 404}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.PauseMonitor(context.Background(), "42"); err == nil {
+		t.Fatal("Expected error for 404 response, got nil")
+	}
+}
+
+// TestListAlerts tests the ListAlerts client method.
+func TestListAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/projects/1/alerts" {
+			t.Errorf("Expected path /projects/1/alerts, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("monitorId"); got != "42" {
+			t.Errorf("Expected monitorId 42, got %q", got)
+		}
+		if got := r.URL.Query()["state"]; len(got) != 2 || got[0] != "open" || got[1] != "firing" {
+			t.Errorf("Expected states [open firing], got %v", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"alerts": [{"id": 7, "projectId": 1, "monitorId": 42, "name": "avg($cpu) > 90",
+			"type": "metric", "state": "firing", "attrs": {"host_name": "web-1"}, "firstSeenAt": 1767348994143}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	monitorID := int64(42)
+	states := []generated.AlertState{generated.AlertStateOpen, generated.AlertStateFiring}
+	alerts, err := c.ListAlerts(context.Background(), generated.ListAlertsParams{MonitorId: &monitorID, State: &states})
+	if err != nil {
+		t.Fatalf("ListAlerts failed: %v", err)
+	}
+
+	if len(alerts) != 1 {
+		t.Fatalf("Expected 1 alert, got %d", len(alerts))
+	}
+	if alerts[0].State != generated.AlertStateFiring {
+		t.Errorf("Expected state firing, got %s", alerts[0].State)
+	}
+	if alerts[0].Attrs == nil || (*alerts[0].Attrs)["host_name"] != "web-1" {
+		t.Errorf("Expected attribute host_name=web-1, got %v", alerts[0].Attrs)
+	}
+}
+
+// TestListAlerts_NoFilter tests that ListAlerts omits unset filters.
+func TestListAlerts_NoFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query parameters, got %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"alerts": []}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	alerts, err := c.ListAlerts(context.Background(), generated.ListAlertsParams{})
+	if err != nil {
+		t.Fatalf("ListAlerts failed: %v", err)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("Expected no alerts, got %d", len(alerts))
+	}
+}
+
 // TestPinDashboard_Error tests error handling in PinDashboard.
 func TestPinDashboard_Error(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -183,3 +301,344 @@ func TestCloneDashboard_Error(t *testing.T) {
 		t.Fatal("Expected error for 400 response, got nil")
 	}
 }
+
+// TestCreateGridRow tests the CreateGridRow client method.
+func TestCreateGridRow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/metrics/1/dashboards/123/rows" {
+			t.Errorf("Expected path /metrics/1/dashboards/123/rows, got %s", r.URL.Path)
+		}
+
+		var body generated.CreateGridRowJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if body.Title == nil || *body.Title != "Traffic" {
+			t.Errorf("Expected title Traffic, got %v", body.Title)
+		}
+
+		// Return created row
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		response := map[string]generated.GridRow{
+			"gridRow": {Id: 7, DashId: 123, Title: *body.Title, Index: 2},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	title := "Traffic"
+	row, err := c.CreateGridRow(context.Background(), 123, generated.CreateGridRowJSONRequestBody{Title: &title})
+	if err != nil {
+		t.Fatalf("CreateGridRow failed: %v", err)
+	}
+
+	if row.Id != 7 {
+		t.Errorf("Expected row ID 7, got %d", row.Id)
+	}
+	if row.Index != 2 {
+		t.Errorf("Expected row index 2, got %d", row.Index)
+	}
+}
+
+// TestGetGridRow tests that GetGridRow finds a row in the dashboard response.
+func TestGetGridRow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/metrics/1/dashboards/123" {
+			t.Errorf("Expected path /metrics/1/dashboards/123, got %s", r.URL.Path)
+		}
+
+		// Return dashboard with rows
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		response := map[string]interface{}{
+			"dashboard": generated.Dashboard{Id: 123, ProjectId: 1, Name: "Dashboard"},
+			"gridRows": []generated.GridRow{
+				{Id: 7, DashId: 123, Title: "Traffic", Index: 0},
+				{Id: 8, DashId: 123, Title: "Resources", Index: 1},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	row, err := c.GetGridRow(context.Background(), 123, 8)
+	if err != nil {
+		t.Fatalf("GetGridRow failed: %v", err)
+	}
+	if row.Title != "Resources" {
+		t.Errorf("Expected row title Resources, got %s", row.Title)
+	}
+
+	_, err = c.GetGridRow(context.Background(), 123, 9)
+	if err == nil {
+		t.Fatal("Expected error for missing grid row, got nil")
+	}
+}
+
+// TestMoveGridRow tests the MoveGridRowUp and MoveGridRowDown client methods.
+func TestMoveGridRow(t *testing.T) {
+	tests := []struct {
+		name         string
+		expectedPath string
+		move         func(c *client.Client) (*generated.GridRow, error)
+	}{
+		{
+			name:         "up",
+			expectedPath: "/metrics/1/dashboards/123/rows/7/up",
+			move: func(c *client.Client) (*generated.GridRow, error) {
+				return c.MoveGridRowUp(context.Background(), 123, 7)
+			},
+		},
+		{
+			name:         "down",
+			expectedPath: "/metrics/1/dashboards/123/rows/7/down",
+			move: func(c *client.Client) (*generated.GridRow, error) {
+				return c.MoveGridRowDown(context.Background(), 123, 7)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Verify request
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT request, got %s", r.Method)
+				}
+				if r.URL.Path != tt.expectedPath {
+					t.Errorf("Expected path %s, got %s", tt.expectedPath, r.URL.Path)
+				}
+
+				// Return moved row
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				response := map[string]generated.GridRow{
+					"gridRow": {Id: 7, DashId: 123, Title: "Traffic", Index: 1},
+				}
+				if err := json.NewEncoder(w).Encode(response); err != nil {
+					t.Fatalf("Failed to encode response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			c := newTestClient(server)
+			row, err := tt.move(c)
+			if err != nil {
+				t.Fatalf("Move grid row failed: %v", err)
+			}
+			if row.Index != 1 {
+				t.Errorf("Expected row index 1, got %d", row.Index)
+			}
+		})
+	}
+}
+
+// TestDeleteGridRow tests the DeleteGridRow client method.
+func TestDeleteGridRow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/metrics/1/dashboards/123/rows/7" {
+			t.Errorf("Expected path /metrics/1/dashboards/123/rows/7, got %s", r.URL.Path)
+		}
+
+		// Return success
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	err := c.DeleteGridRow(context.Background(), 123, 7)
+	if err != nil {
+		t.Fatalf("DeleteGridRow failed: %v", err)
+	}
+}
+
+// TestCreateGridItem tests the CreateGridItem client method.
+func TestCreateGridItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/metrics/1/dashboards/123/grid" {
+			t.Errorf("Expected path /metrics/1/dashboards/123/grid, got %s", r.URL.Path)
+		}
+
+		var body generated.GridItem
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if body.Type != generated.GridItemTypeHeatmap {
+			t.Errorf("Expected type heatmap, got %s", body.Type)
+		}
+
+		// Return created item
+		body.Id = 42
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		response := map[string]generated.GridItem{"gridItem": body}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	var params generated.GridItem_Params
+	if err := params.FromHeatmapGridItemParams(generated.HeatmapGridItemParams{
+		Metric: "uptrace_tracing_spans",
+		Query:  "_duration",
+	}); err != nil {
+		t.Fatalf("Failed to build params: %v", err)
+	}
+
+	c := newTestClient(server)
+	item, err := c.CreateGridItem(context.Background(), 123, generated.GridItem{
+		DashId:   123,
+		DashKind: generated.GridItemDashKindTable,
+		Title:    "Latency",
+		Type:     generated.GridItemTypeHeatmap,
+		Params:   &params,
+	})
+	if err != nil {
+		t.Fatalf("CreateGridItem failed: %v", err)
+	}
+
+	if item.Id != 42 {
+		t.Errorf("Expected item ID 42, got %d", item.Id)
+	}
+	heatmap, err := item.Params.AsHeatmapGridItemParams()
+	if err != nil {
+		t.Fatalf("Failed to parse params: %v", err)
+	}
+	if heatmap.Metric != "uptrace_tracing_spans" {
+		t.Errorf("Expected metric uptrace_tracing_spans, got %s", heatmap.Metric)
+	}
+}
+
+// TestGetGridItem tests that GetGridItem finds items in grid rows and in the table section.
+func TestGetGridItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.URL.Path != "/metrics/1/dashboards/123" {
+			t.Errorf("Expected path /metrics/1/dashboards/123, got %s", r.URL.Path)
+		}
+
+		// Return dashboard with row items and table items
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		rowID := int64(7)
+		response := map[string]interface{}{
+			"dashboard": generated.Dashboard{Id: 123, ProjectId: 1, Name: "Dashboard"},
+			"gridRows": []generated.GridRow{
+				{Id: 7, DashId: 123, Title: "Traffic", Items: &[]generated.GridItem{
+					{Id: 10, DashId: 123, RowId: &rowID, Title: "Requests", Type: generated.GridItemTypeChart},
+				}},
+			},
+			"tableItems": []generated.GridItem{
+				{Id: 20, DashId: 123, Title: "Top spans", Type: generated.GridItemTypeTable},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	item, err := c.GetGridItem(context.Background(), 123, 10)
+	if err != nil {
+		t.Fatalf("GetGridItem failed: %v", err)
+	}
+	if item.Title != "Requests" {
+		t.Errorf("Expected item title Requests, got %s", item.Title)
+	}
+
+	item, err = c.GetGridItem(context.Background(), 123, 20)
+	if err != nil {
+		t.Fatalf("GetGridItem failed: %v", err)
+	}
+	if item.Title != "Top spans" {
+		t.Errorf("Expected item title Top spans, got %s", item.Title)
+	}
+
+	_, err = c.GetGridItem(context.Background(), 123, 30)
+	if err == nil {
+		t.Fatal("Expected error for missing grid item, got nil")
+	}
+}
+
+// TestDeleteGridItem tests the DeleteGridItem client method.
+func TestDeleteGridItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/metrics/1/dashboards/123/grid/42" {
+			t.Errorf("Expected path /metrics/1/dashboards/123/grid/42, got %s", r.URL.Path)
+		}
+
+		// Return success
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	err := c.DeleteGridItem(context.Background(), 123, 42)
+	if err != nil {
+		t.Fatalf("DeleteGridItem failed: %v", err)
+	}
+}
+
+// TestForProject tests that ForProject sends requests to the overridden project.
+func TestForProject(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	projectClient := c.ForProject(7)
+
+	if projectClient.ProjectID() != 7 {
+		t.Errorf("Expected project ID 7, got %d", projectClient.ProjectID())
+	}
+	if c.ProjectID() != 1 {
+		t.Errorf("Expected original client to keep project ID 1, got %d", c.ProjectID())
+	}
+	if c.ForProject(0) != c || c.ForProject(1) != c {
+		t.Error("Expected ForProject to return the same client for the default project")
+	}
+
+	if err := projectClient.PinDashboard(context.Background(), 123); err != nil {
+		t.Fatalf("PinDashboard failed: %v", err)
+	}
+	if err := c.PinDashboard(context.Background(), 123); err != nil {
+		t.Fatalf("PinDashboard failed: %v", err)
+	}
+
+	expected := []string{"/metrics/7/dashboards/123/pinned", "/metrics/1/dashboards/123/pinned"}
+	if len(paths) != len(expected) || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected paths %v, got %v", expected, paths)
+	}
+}
@@ -0,0 +1,19 @@
+package client
+
+import "github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+
+// Monitor params Uptrace fills in when they are omitted. The provider keeps them out of
+// the state of imported monitors and the exporter out of the generated configuration,
+// so both produce the same minimal configuration.
+const (
+	DefaultCheckNumPoint    = 1
+	DefaultNullsMode        = generated.MetricMonitorParamsNullsModeAllow
+	DefaultGroupingInterval = 60000 // milliseconds
+	DefaultTimeOffset       = 0     // milliseconds
+
+	DefaultUptimeMethod          = generated.UptimeMonitorParamsMethodGET
+	DefaultUptimeStatusCode      = 200
+	DefaultUptimeCheckInterval   = 60 // seconds
+	DefaultUptimeTimeout         = 10 // seconds
+	DefaultUptimeFollowRedirects = true
+)
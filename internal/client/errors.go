@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Sentinel errors for common API failures. Use errors.Is to match them
+// against errors returned by the Client.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+)
+
+// APIError is returned when the Uptrace API responds with a non-success status code.
+// Its fields are parsed from the API Error schema when the body matches it.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Code is the machine-readable error code, e.g. "invalid_request"
+	Code string
+	// Message is the human-readable error message, or the raw body if it could not be parsed
+	Message string
+	// TraceID identifies the failed request in Uptrace; include it when contacting support
+	TraceID string
+	// Details holds additional error details, if any
+	Details map[string]any
+}
+
+// Error returns a message of the form "<status>: <message> (trace ID: <id>)".
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		b.WriteString("bad request")
+	case http.StatusUnauthorized:
+		b.WriteString("unauthorized")
+	case http.StatusForbidden:
+		b.WriteString("forbidden")
+	case http.StatusNotFound:
+		b.WriteString("not found")
+	case http.StatusInternalServerError:
+		b.WriteString("internal server error")
+	default:
+		fmt.Fprintf(&b, "unexpected status code %d", e.StatusCode)
+	}
+
+	if msg := e.message(); msg != "" {
+		b.WriteString(": ")
+		b.WriteString(msg)
+	}
+	if e.Code != "" {
+		fmt.Fprintf(&b, " [%s]", e.Code)
+	}
+	if e.TraceID != "" {
+		fmt.Fprintf(&b, " (trace ID: %s)", e.TraceID)
+	}
+
+	return b.String()
+}
+
+// message returns the API message, falling back to a default for well-known statuses.
+func (e *APIError) message() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return "invalid or missing authentication token"
+	case http.StatusForbidden:
+		return "insufficient permissions"
+	case http.StatusNotFound:
+		return "resource does not exist"
+	default:
+		return ""
+	}
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
+
+// newAPIError builds an APIError from an error response.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+
+	var parsed generated.Error
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.Error.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	apiErr.Code = parsed.Error.Code
+	apiErr.Message = parsed.Error.Message
+	if parsed.TraceId != nil {
+		apiErr.TraceID = *parsed.TraceId
+	}
+	if parsed.Details != nil {
+		apiErr.Details = *parsed.Details
+	}
+
+	return apiErr
+}
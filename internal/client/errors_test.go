@@ -0,0 +1,125 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// TestAPIError_Parsed tests that error responses are parsed into an *APIError.
+func TestAPIError_Parsed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		response := map[string]interface{}{
+			"error": map[string]string{
+				"code":    "not_found",
+				"message": "Monitor not found",
+			},
+			"statusCode": 404,
+			"traceId":    "1886e276492f61adfc95791a529e2bf1",
+			"details":    map[string]interface{}{"monitorId": "123"},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.GetMonitor(context.Background(), "123")
+	if err == nil {
+		t.Fatal("Expected error for 404 response, got nil")
+	}
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *client.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Code != "not_found" {
+		t.Errorf("Expected code not_found, got %s", apiErr.Code)
+	}
+	if apiErr.Message != "Monitor not found" {
+		t.Errorf("Expected message 'Monitor not found', got %s", apiErr.Message)
+	}
+	if apiErr.TraceID != "1886e276492f61adfc95791a529e2bf1" {
+		t.Errorf("Expected trace ID, got %s", apiErr.TraceID)
+	}
+	if apiErr.Details["monitorId"] != "123" {
+		t.Errorf("Expected details to contain monitorId, got %v", apiErr.Details)
+	}
+	if !strings.Contains(err.Error(), "trace ID: 1886e276492f61adfc95791a529e2bf1") {
+		t.Errorf("Expected error message to include the trace ID, got %q", err.Error())
+	}
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Error("Expected errors.Is(err, client.ErrNotFound) to be true")
+	}
+	if errors.Is(err, client.ErrUnauthorized) {
+		t.Error("Expected errors.Is(err, client.ErrUnauthorized) to be false")
+	}
+}
+
+// TestAPIError_UnparsableBody tests that a non-JSON body is kept as the message.
+func TestAPIError_UnparsableBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("token expired\n"))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.ListMonitors(context.Background())
+	if err == nil {
+		t.Fatal("Expected error for 401 response, got nil")
+	}
+
+	if !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("Expected errors.Is(err, client.ErrUnauthorized) to be true, got %v", err)
+	}
+	if err.Error() != "unauthorized: token expired" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+// TestAPIError_NotFoundMessageOnOtherStatus tests that messages mentioning "not found"
+// don't match ErrNotFound unless the status is 404.
+func TestAPIError_NotFoundMessageOnOtherStatus(t *testing.T) {
+	err := &client.APIError{StatusCode: http.StatusBadRequest, Message: "metric system.cpu.utilization not found"}
+
+	if errors.Is(err, client.ErrNotFound) {
+		t.Error("Expected a 400 error not to match ErrNotFound")
+	}
+	if err.Error() != "bad request: metric system.cpu.utilization not found" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+// TestGetGridItem_NotFound tests that a missing grid item matches ErrNotFound.
+func TestGetGridItem_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		response := map[string]interface{}{
+			"dashboard": map[string]interface{}{"id": 123, "projectId": 1, "name": "Test"},
+			"gridRows":  []interface{}{},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	_, err := c.GetGridItem(context.Background(), 123, 456)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Expected errors.Is(err, client.ErrNotFound) to be true, got %v", err)
+	}
+}
@@ -26,6 +26,13 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AlertState.
+const (
+	AlertStateClosed AlertState = "closed"
+	AlertStateFiring AlertState = "firing"
+	AlertStateOpen   AlertState = "open"
+)
+
 // Defines values for ChartGridItemParamsChartKind.
 const (
 	ChartGridItemParamsChartKindArea        ChartGridItemParamsChartKind = "area"
@@ -98,7 +105,10 @@ const (
 // Defines values for MonitorType.
 const (
 	MonitorTypeError  MonitorType = "error"
+	MonitorTypeLog    MonitorType = "log"
 	MonitorTypeMetric MonitorType = "metric"
+	MonitorTypeSpan   MonitorType = "span"
+	MonitorTypeUptime MonitorType = "uptime"
 )
 
 // Defines values for NotificationChannelPriority.
@@ -159,6 +169,17 @@ const (
 	TimeseriesStyleSymbolTriangle TimeseriesStyleSymbol = "triangle"
 )
 
+// Defines values for UptimeMonitorParamsMethod.
+const (
+	UptimeMonitorParamsMethodDELETE  UptimeMonitorParamsMethod = "DELETE"
+	UptimeMonitorParamsMethodGET     UptimeMonitorParamsMethod = "GET"
+	UptimeMonitorParamsMethodHEAD    UptimeMonitorParamsMethod = "HEAD"
+	UptimeMonitorParamsMethodOPTIONS UptimeMonitorParamsMethod = "OPTIONS"
+	UptimeMonitorParamsMethodPATCH   UptimeMonitorParamsMethod = "PATCH"
+	UptimeMonitorParamsMethodPOST    UptimeMonitorParamsMethod = "POST"
+	UptimeMonitorParamsMethodPUT     UptimeMonitorParamsMethod = "PUT"
+)
+
 // Defines values for ValueMappingOp.
 const (
 	ValueMappingOpAny ValueMappingOp = "any"
@@ -169,6 +190,47 @@ const (
 	ValueMappingOpLte ValueMappingOp = "lte"
 )
 
+// Alert defines model for Alert.
+type Alert struct {
+	// Attrs Attributes of the alerting time series or error group
+	Attrs *map[string]string `json:"attrs,omitempty"`
+
+	// CreatedAt Alert creation timestamp (Unix milliseconds)
+	CreatedAt *float64 `json:"createdAt,omitempty"`
+
+	// FirstSeenAt When the condition was first observed (Unix milliseconds)
+	FirstSeenAt *float64 `json:"firstSeenAt,omitempty"`
+
+	// Id Alert unique identifier
+	Id int64 `json:"id"`
+
+	// LastSeenAt When the condition was last observed (Unix milliseconds)
+	LastSeenAt *float64 `json:"lastSeenAt,omitempty"`
+
+	// MonitorId ID of the monitor that created the alert. Absent for alerts not created by a monitor.
+	MonitorId *int64 `json:"monitorId,omitempty"`
+
+	// Name Alert name
+	Name string `json:"name"`
+
+	// ProjectId Project ID
+	ProjectId int64 `json:"projectId"`
+
+	// State Alert state. Open alerts wait for the condition to be confirmed, firing alerts have
+	// notified the monitor channels, and closed alerts are resolved.
+	State AlertState `json:"state"`
+
+	// Type Alert type, such as metric or error
+	Type string `json:"type"`
+
+	// UpdatedAt Alert last update timestamp (Unix milliseconds)
+	UpdatedAt *float64 `json:"updatedAt,omitempty"`
+}
+
+// AlertState Alert state. Open alerts wait for the condition to be confirmed, firing alerts have
+// notified the monitor channels, and closed alerts are resolved.
+type AlertState string
+
 // ChartGridItemParams defines model for ChartGridItemParams.
 type ChartGridItemParams struct {
 	// ChartKind Chart visualization type
@@ -434,6 +496,27 @@ type HeatmapGridItemParams struct {
 	Unit *string `json:"unit,omitempty"`
 }
 
+// LogMonitorParams defines model for LogMonitorParams.
+type LogMonitorParams struct {
+	// CheckNumPoint Number of consecutive points that must breach threshold
+	CheckNumPoint *int `json:"checkNumPoint,omitempty"`
+
+	// Column Column name to evaluate in the query result
+	Column string `json:"column"`
+
+	// GroupingInterval Grouping interval in milliseconds
+	GroupingInterval *float64 `json:"groupingInterval,omitempty"`
+
+	// MaxAllowedValue Maximum allowed value for the column
+	MaxAllowedValue *float64 `json:"maxAllowedValue,omitempty"`
+
+	// MinAllowedValue Minimum allowed value for the column
+	MinAllowedValue *float64 `json:"minAllowedValue,omitempty"`
+
+	// Query UQL query over logs, aggregating the matching log records
+	Query string `json:"query"`
+}
+
 // MetricAlias defines model for MetricAlias.
 type MetricAlias struct {
 	// Alias Metric alias
@@ -525,7 +608,12 @@ type Monitor struct {
 	// Note: Self-hosted Uptrace v2.0.2 and earlier do not use this field.
 	TrendAggFunc *string `json:"trendAggFunc,omitempty"`
 
-	// Type Type of monitor
+	// Type Type of monitor:
+	// - metric: evaluates a UQL query over metrics
+	// - error: notifies about new errors
+	// - span: evaluates a UQL query over spans
+	// - log: evaluates a UQL query over logs
+	// - uptime: periodically sends an HTTP request to a URL
 	Type MonitorType `json:"type"`
 
 	// UpdatedAt Monitor last update timestamp (Unix milliseconds)
@@ -562,7 +650,12 @@ type MonitorInput struct {
 	// Note: Self-hosted Uptrace v2.0.2 and earlier do not use this field.
 	TrendAggFunc *string `json:"trendAggFunc,omitempty"`
 
-	// Type Type of monitor
+	// Type Type of monitor:
+	// - metric: evaluates a UQL query over metrics
+	// - error: notifies about new errors
+	// - span: evaluates a UQL query over spans
+	// - log: evaluates a UQL query over logs
+	// - uptime: periodically sends an HTTP request to a URL
 	Type MonitorType `json:"type"`
 }
 
@@ -571,7 +664,12 @@ type MonitorInput_Params struct {
 	union json.RawMessage
 }
 
-// MonitorType Type of monitor
+// MonitorType Type of monitor:
+// - metric: evaluates a UQL query over metrics
+// - error: notifies about new errors
+// - span: evaluates a UQL query over spans
+// - log: evaluates a UQL query over logs
+// - uptime: periodically sends an HTTP request to a URL
 type MonitorType string
 
 // NotificationChannel defines model for NotificationChannel.
@@ -669,6 +767,27 @@ type RepeatInterval struct {
 // RepeatIntervalStrategy Repeat interval strategy
 type RepeatIntervalStrategy string
 
+// SpanMonitorParams defines model for SpanMonitorParams.
+type SpanMonitorParams struct {
+	// CheckNumPoint Number of consecutive points that must breach threshold
+	CheckNumPoint *int `json:"checkNumPoint,omitempty"`
+
+	// Column Column name to evaluate in the query result
+	Column string `json:"column"`
+
+	// GroupingInterval Grouping interval in milliseconds
+	GroupingInterval *float64 `json:"groupingInterval,omitempty"`
+
+	// MaxAllowedValue Maximum allowed value for the column
+	MaxAllowedValue *float64 `json:"maxAllowedValue,omitempty"`
+
+	// MinAllowedValue Minimum allowed value for the column
+	MinAllowedValue *float64 `json:"minAllowedValue,omitempty"`
+
+	// Query UQL query over spans, aggregating the matching spans
+	Query string `json:"query"`
+}
+
 // TableColumn defines model for TableColumn.
 type TableColumn struct {
 	// AggFunc Aggregation function for table display
@@ -726,6 +845,36 @@ type TimeseriesStyle struct {
 // TimeseriesStyleSymbol Symbol type for data points
 type TimeseriesStyleSymbol string
 
+// UptimeMonitorParams defines model for UptimeMonitorParams.
+type UptimeMonitorParams struct {
+	// Body HTTP request body
+	Body *string `json:"body,omitempty"`
+
+	// CheckInterval Interval between checks in seconds
+	CheckInterval *int64 `json:"checkInterval,omitempty"`
+
+	// ExpectedStatusCodes Status codes of a successful check
+	ExpectedStatusCodes *[]int `json:"expectedStatusCodes,omitempty"`
+
+	// FollowRedirects Whether to follow redirects
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+
+	// Headers HTTP request headers
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Method HTTP request method
+	Method *UptimeMonitorParamsMethod `json:"method,omitempty"`
+
+	// Timeout Request timeout in seconds
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// Url URL to check
+	Url string `json:"url"`
+}
+
+// UptimeMonitorParamsMethod HTTP request method
+type UptimeMonitorParamsMethod string
+
 // ValueMapping defines model for ValueMapping.
 type ValueMapping struct {
 	// Color Color for this mapping (hex format)
@@ -800,6 +949,15 @@ type UpdateDashboardTableJSONBody struct {
 	TableQuery *string `json:"tableQuery,omitempty"`
 }
 
+// ListAlertsParams defines parameters for ListAlerts.
+type ListAlertsParams struct {
+	// MonitorId Only return alerts created by this monitor
+	MonitorId *int64 `form:"monitorId,omitempty" json:"monitorId,omitempty"`
+
+	// State Only return alerts in one of these states
+	State *[]AlertState `form:"state,omitempty" json:"state,omitempty"`
+}
+
 // CreateGridItemJSONRequestBody defines body for CreateGridItem for application/json ContentType.
 type CreateGridItemJSONRequestBody = GridItem
 
@@ -996,6 +1154,84 @@ func (t *Monitor_Params) MergeErrorMonitorParams(v ErrorMonitorParams) error {
 	return err
 }
 
+// AsSpanMonitorParams returns the union data inside the Monitor_Params as a SpanMonitorParams
+func (t Monitor_Params) AsSpanMonitorParams() (SpanMonitorParams, error) {
+	var body SpanMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSpanMonitorParams overwrites any union data inside the Monitor_Params as the provided SpanMonitorParams
+func (t *Monitor_Params) FromSpanMonitorParams(v SpanMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSpanMonitorParams performs a merge with any union data inside the Monitor_Params, using the provided SpanMonitorParams
+func (t *Monitor_Params) MergeSpanMonitorParams(v SpanMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsLogMonitorParams returns the union data inside the Monitor_Params as a LogMonitorParams
+func (t Monitor_Params) AsLogMonitorParams() (LogMonitorParams, error) {
+	var body LogMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLogMonitorParams overwrites any union data inside the Monitor_Params as the provided LogMonitorParams
+func (t *Monitor_Params) FromLogMonitorParams(v LogMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeLogMonitorParams performs a merge with any union data inside the Monitor_Params, using the provided LogMonitorParams
+func (t *Monitor_Params) MergeLogMonitorParams(v LogMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUptimeMonitorParams returns the union data inside the Monitor_Params as a UptimeMonitorParams
+func (t Monitor_Params) AsUptimeMonitorParams() (UptimeMonitorParams, error) {
+	var body UptimeMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUptimeMonitorParams overwrites any union data inside the Monitor_Params as the provided UptimeMonitorParams
+func (t *Monitor_Params) FromUptimeMonitorParams(v UptimeMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUptimeMonitorParams performs a merge with any union data inside the Monitor_Params, using the provided UptimeMonitorParams
+func (t *Monitor_Params) MergeUptimeMonitorParams(v UptimeMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Monitor_Params) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1058,6 +1294,84 @@ func (t *MonitorInput_Params) MergeErrorMonitorParams(v ErrorMonitorParams) erro
 	return err
 }
 
+// AsSpanMonitorParams returns the union data inside the MonitorInput_Params as a SpanMonitorParams
+func (t MonitorInput_Params) AsSpanMonitorParams() (SpanMonitorParams, error) {
+	var body SpanMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSpanMonitorParams overwrites any union data inside the MonitorInput_Params as the provided SpanMonitorParams
+func (t *MonitorInput_Params) FromSpanMonitorParams(v SpanMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSpanMonitorParams performs a merge with any union data inside the MonitorInput_Params, using the provided SpanMonitorParams
+func (t *MonitorInput_Params) MergeSpanMonitorParams(v SpanMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsLogMonitorParams returns the union data inside the MonitorInput_Params as a LogMonitorParams
+func (t MonitorInput_Params) AsLogMonitorParams() (LogMonitorParams, error) {
+	var body LogMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLogMonitorParams overwrites any union data inside the MonitorInput_Params as the provided LogMonitorParams
+func (t *MonitorInput_Params) FromLogMonitorParams(v LogMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeLogMonitorParams performs a merge with any union data inside the MonitorInput_Params, using the provided LogMonitorParams
+func (t *MonitorInput_Params) MergeLogMonitorParams(v LogMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUptimeMonitorParams returns the union data inside the MonitorInput_Params as a UptimeMonitorParams
+func (t MonitorInput_Params) AsUptimeMonitorParams() (UptimeMonitorParams, error) {
+	var body UptimeMonitorParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUptimeMonitorParams overwrites any union data inside the MonitorInput_Params as the provided UptimeMonitorParams
+func (t *MonitorInput_Params) FromUptimeMonitorParams(v UptimeMonitorParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUptimeMonitorParams performs a merge with any union data inside the MonitorInput_Params, using the provided UptimeMonitorParams
+func (t *MonitorInput_Params) MergeUptimeMonitorParams(v UptimeMonitorParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t MonitorInput_Params) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1213,6 +1527,9 @@ type ClientInterface interface {
 	// UpdateDashboardFromYAMLWithBody request with any body
 	UpdateDashboardFromYAMLWithBody(ctx context.Context, projectId ProjectId, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAlerts request
+	ListAlerts(ctx context.Context, projectId ProjectId, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMonitors request
 	ListMonitors(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1232,6 +1549,12 @@ type ClientInterface interface {
 
 	UpdateMonitor(ctx context.Context, projectId ProjectId, monitorId MonitorId, body UpdateMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ActivateMonitor request
+	ActivateMonitor(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseMonitor request
+	PauseMonitor(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListNotificationChannels request
 	ListNotificationChannels(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1564,6 +1887,18 @@ func (c *Client) UpdateDashboardFromYAMLWithBody(ctx context.Context, projectId
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListAlerts(ctx context.Context, projectId ProjectId, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAlertsRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListMonitors(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListMonitorsRequest(c.Server, projectId)
 	if err != nil {
@@ -1648,6 +1983,30 @@ func (c *Client) UpdateMonitor(ctx context.Context, projectId ProjectId, monitor
 	return c.Client.Do(req)
 }
 
+func (c *Client) ActivateMonitor(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewActivateMonitorRequest(c.Server, projectId, monitorId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PauseMonitor(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseMonitorRequest(c.Server, projectId, monitorId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListNotificationChannels(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListNotificationChannelsRequest(c.Server, projectId)
 	if err != nil {
@@ -2662,6 +3021,78 @@ func NewUpdateDashboardFromYAMLRequestWithBody(server string, projectId ProjectI
 	return req, nil
 }
 
+// NewListAlertsRequest generates requests for ListAlerts
+func NewListAlertsRequest(server string, projectId ProjectId, params *ListAlertsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/alerts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MonitorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "monitorId", runtime.ParamLocationQuery, *params.MonitorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewListMonitorsRequest generates requests for ListMonitors
 func NewListMonitorsRequest(server string, projectId ProjectId) (*http.Request, error) {
 	var err error
@@ -2776,16 +3207,111 @@ func NewDeleteMonitorRequest(server string, projectId ProjectId, monitorId Monit
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMonitorRequest generates requests for GetMonitor
+func NewGetMonitorRequest(server string, projectId ProjectId, monitorId MonitorId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "monitorId", runtime.ParamLocationPath, monitorId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/monitors/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMonitorRequest calls the generic UpdateMonitor builder with application/json body
+func NewUpdateMonitorRequest(server string, projectId ProjectId, monitorId MonitorId, body UpdateMonitorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonitorRequestWithBody(server, projectId, monitorId, "application/json", bodyReader)
+}
+
+// NewUpdateMonitorRequestWithBody generates requests for UpdateMonitor with any type of body
+func NewUpdateMonitorRequestWithBody(server string, projectId ProjectId, monitorId MonitorId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "monitorId", runtime.ParamLocationPath, monitorId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/monitors/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetMonitorRequest generates requests for GetMonitor
-func NewGetMonitorRequest(server string, projectId ProjectId, monitorId MonitorId) (*http.Request, error) {
+// NewActivateMonitorRequest generates requests for ActivateMonitor
+func NewActivateMonitorRequest(server string, projectId ProjectId, monitorId MonitorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2807,7 +3333,7 @@ func NewGetMonitorRequest(server string, projectId ProjectId, monitorId MonitorI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/monitors/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/monitors/%s/active", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2817,7 +3343,7 @@ func NewGetMonitorRequest(server string, projectId ProjectId, monitorId MonitorI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2825,19 +3351,8 @@ func NewGetMonitorRequest(server string, projectId ProjectId, monitorId MonitorI
 	return req, nil
 }
 
-// NewUpdateMonitorRequest calls the generic UpdateMonitor builder with application/json body
-func NewUpdateMonitorRequest(server string, projectId ProjectId, monitorId MonitorId, body UpdateMonitorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMonitorRequestWithBody(server, projectId, monitorId, "application/json", bodyReader)
-}
-
-// NewUpdateMonitorRequestWithBody generates requests for UpdateMonitor with any type of body
-func NewUpdateMonitorRequestWithBody(server string, projectId ProjectId, monitorId MonitorId, contentType string, body io.Reader) (*http.Request, error) {
+// NewPauseMonitorRequest generates requests for PauseMonitor
+func NewPauseMonitorRequest(server string, projectId ProjectId, monitorId MonitorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2859,7 +3374,7 @@ func NewUpdateMonitorRequestWithBody(server string, projectId ProjectId, monitor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/monitors/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/monitors/%s/paused", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2869,13 +3384,11 @@ func NewUpdateMonitorRequestWithBody(server string, projectId ProjectId, monitor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
@@ -3211,6 +3724,9 @@ type ClientWithResponsesInterface interface {
 	// UpdateDashboardFromYAMLWithBodyWithResponse request with any body
 	UpdateDashboardFromYAMLWithBodyWithResponse(ctx context.Context, projectId ProjectId, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardFromYAMLResponse, error)
 
+	// ListAlertsWithResponse request
+	ListAlertsWithResponse(ctx context.Context, projectId ProjectId, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error)
+
 	// ListMonitorsWithResponse request
 	ListMonitorsWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListMonitorsResponse, error)
 
@@ -3230,6 +3746,12 @@ type ClientWithResponsesInterface interface {
 
 	UpdateMonitorWithResponse(ctx context.Context, projectId ProjectId, monitorId MonitorId, body UpdateMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitorResponse, error)
 
+	// ActivateMonitorWithResponse request
+	ActivateMonitorWithResponse(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*ActivateMonitorResponse, error)
+
+	// PauseMonitorWithResponse request
+	PauseMonitorWithResponse(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*PauseMonitorResponse, error)
+
 	// ListNotificationChannelsWithResponse request
 	ListNotificationChannelsWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListNotificationChannelsResponse, error)
 
@@ -3811,6 +4333,34 @@ func (r UpdateDashboardFromYAMLResponse) StatusCode() int {
 	return 0
 }
 
+type ListAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Alerts []Alert `json:"alerts"`
+	}
+	JSON400 *BadRequest
+	JSON401 *Unauthorized
+	JSON403 *Forbidden
+	JSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListMonitorsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3951,6 +4501,56 @@ func (r UpdateMonitorResponse) StatusCode() int {
 	return 0
 }
 
+type ActivateMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ActivateMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ActivateMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListNotificationChannelsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4319,6 +4919,15 @@ func (c *ClientWithResponses) UpdateDashboardFromYAMLWithBodyWithResponse(ctx co
 	return ParseUpdateDashboardFromYAMLResponse(rsp)
 }
 
+// ListAlertsWithResponse request returning *ListAlertsResponse
+func (c *ClientWithResponses) ListAlertsWithResponse(ctx context.Context, projectId ProjectId, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error) {
+	rsp, err := c.ListAlerts(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAlertsResponse(rsp)
+}
+
 // ListMonitorsWithResponse request returning *ListMonitorsResponse
 func (c *ClientWithResponses) ListMonitorsWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListMonitorsResponse, error) {
 	rsp, err := c.ListMonitors(ctx, projectId, reqEditors...)
@@ -4380,6 +4989,24 @@ func (c *ClientWithResponses) UpdateMonitorWithResponse(ctx context.Context, pro
 	return ParseUpdateMonitorResponse(rsp)
 }
 
+// ActivateMonitorWithResponse request returning *ActivateMonitorResponse
+func (c *ClientWithResponses) ActivateMonitorWithResponse(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*ActivateMonitorResponse, error) {
+	rsp, err := c.ActivateMonitor(ctx, projectId, monitorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseActivateMonitorResponse(rsp)
+}
+
+// PauseMonitorWithResponse request returning *PauseMonitorResponse
+func (c *ClientWithResponses) PauseMonitorWithResponse(ctx context.Context, projectId ProjectId, monitorId MonitorId, reqEditors ...RequestEditorFn) (*PauseMonitorResponse, error) {
+	rsp, err := c.PauseMonitor(ctx, projectId, monitorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseMonitorResponse(rsp)
+}
+
 // ListNotificationChannelsWithResponse request returning *ListNotificationChannelsResponse
 func (c *ClientWithResponses) ListNotificationChannelsWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListNotificationChannelsResponse, error) {
 	rsp, err := c.ListNotificationChannels(ctx, projectId, reqEditors...)
@@ -5568,6 +6195,62 @@ func ParseUpdateDashboardFromYAMLResponse(rsp *http.Response) (*UpdateDashboardF
 	return response, nil
 }
 
+// ParseListAlertsResponse parses an HTTP response from a ListAlertsWithResponse call
+func ParseListAlertsResponse(rsp *http.Response) (*ListAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Alerts []Alert `json:"alerts"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListMonitorsResponse parses an HTTP response from a ListMonitorsWithResponse call
 func ParseListMonitorsResponse(rsp *http.Response) (*ListMonitorsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -5848,6 +6531,100 @@ func ParseUpdateMonitorResponse(rsp *http.Response) (*UpdateMonitorResponse, err
 	return response, nil
 }
 
+// ParseActivateMonitorResponse parses an HTTP response from a ActivateMonitorWithResponse call
+func ParseActivateMonitorResponse(rsp *http.Response) (*ActivateMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ActivateMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseMonitorResponse parses an HTTP response from a PauseMonitorWithResponse call
+func ParsePauseMonitorResponse(rsp *http.Response) (*PauseMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListNotificationChannelsResponse parses an HTTP response from a ListNotificationChannelsWithResponse call
 func ParseListNotificationChannelsResponse(rsp *http.Response) (*ListNotificationChannelsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -6131,108 +6908,126 @@ func ParseUpdateNotificationChannelResponse(rsp *http.Response) (*UpdateNotifica
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd6VMbu7L/V1Rz3wdyythmyeZvhGy8BwmXwD33VkidiJm2rZsZaY6kMTgU//srLbN5",
-	"NIuNIQvkQwp7NFpa3b9e1Gpfez6LYkaBSuGNrr0YcxyBBK4/7U8xpRAeBOpDAMLnJJaEUW/kfWCSjImP",
-	"1Ufkm3bo4LXX84h6HGM59XoexRF4I8/P+ul5HP5OCIfAG0meQM8T/hQirAYYMx5h6Y08QuWzXa/nRYSS",
-	"KIm80VbPk/MYzCOYAPdubnreayymFwzzwDW/7GHtpILC6+uc1hGjRDLumpR9VDulKHu1aUJ2TCE5oRM9",
-	"5DFn/wVfuoY8iyXHPqDYNKkdOs66WB8tblRXImZUgGanVzg4gb8TEFJ98hmVQPWfOI5Dy0uD/wo17+vC",
-	"oP/DYeyNvH8MclYdmKdi8IZzZocqr/sVDhC3g930vLeMX5AgAHr3I2dDoU1EqEjGY+IToBLFwCMiBGFU",
-	"qCkdUAmc4vAT8Blw092dTy4dFAk9KgLTsKfk+S1LaHD3UzgBwRLuA6JMorEe86bnnVGcyCnj5DvcwxyK",
-	"o+ltmuGQBIhxpHeITpB6DlTacbWU2V4tMHL5jpPgQEJ0rDDToCdnMXBJDLv7qtH/EWqlcoyTUIlOSCh4",
-	"ixPSHaIZEQkOyXeDqlqeeh5QJV+f0/cwB+z1vAvMvZ4nJPa/QbBpv0w/qodfeotA0fN8FiYRPcKxJmsQ",
-	"EDUODo9L024i6BFITvx93Y1Xoar5flPE4CvdgHxGx2SScL0c4WUTYhcKasyEKAVffkjCUJSoNMahgMX+",
-	"/5yCnAJHkiH7IqJJGKIZDhMQiFAkp4A02fPBLhgLAevZhjABsxtNa9RbcWia3vS8SC9ZOMDcPFCzCYiI",
-	"QzxHGxG+QlvDJwpiJUQdybkXEqwRIcJXB+a1raGG1vRTthjMOZ6rpn8nwOcOtP/nIdKP0JhxFzVyXpAk",
-	"AgGcgLgFP5xmnXyS8xCqLJE3yNlCyHmoJlHhh5ui6vmcUT5d7RcHAxU3qyKAEb46BDqRU8fm4Sult1Co",
-	"n2tqGe5AIb6AUA0KVziKQ/BGu8Oqbut5cYh9iCxE5dJ9waRkUUW+zRRR/lIu19kbnEym0im35osyiAhZ",
-	"N0jKjQv4QZnGD/umxBchOAcz0lQa7rOHZxPVuDzev4zcSYbElF0q+bMSVmD/dHTVgeZpT/O5mggWTatN",
-	"ef3GseuZcecAXQ5YQrAnm0xC3UhDrGJPiaMYbZxRcoUiEoZEgM9oIJ4UeWDr+bPnO7svXr7c3drd6T9/",
-	"ub3dy42hgCWKmNlEaRJdGCaZcBIc4as/SdDEhJfqseZB1R4Z2hUG3951MaBq+083DLwL2QUOUyQgoQSO",
-	"tBaFQO0WDsOakTwXUpBG8zqh5O8EEAmUthwT4CWy9aomY3UlCumUXTLDoYNIxsLUW4WIbaZ4rbhXxTGf",
-	"DYfDYafdMaZv/dL08yJ1lKlGfEAfZ+oPuDS8nGLM9tOnei3p5y0HLWNCKQRLqLopoMxRQUQg20FhVvb9",
-	"qrqL672C48wbQHJKRGGICwgZnSihXn4fNabs39bGOM17qTMxypaF0XXqLZSrjApm6AbvOEtitRUldKsA",
-	"W9oMGYNJZNrUoGYB3lrAyw57VLQhakdNDYpEQJAaM/nGVIZewrBwTqoGO/5ZMh+q4zdjhYQoDrEEF9Od",
-	"2meK68i40PUlFsjiNhpzFiGM0n5KAybGl+0HzP8GvK9cBEwocFFn33wcjwU4NIGyShDTD5uQpBuKSMZC",
-	"SWKxb0zSpaTbvoowh9SkhQBhnzMhjOkmnJZsEgftWk4pWGRa3rmim+MoPOMO+D47OVQqZwzSnxa2/D97",
-	"R4eIQ8xBAJXGxypu9YBYR3Uw2xpYqR5sDbIO1Ac1pleYXcJJlREWbEqioLMY69AY/6XJxlBTPaBxIqvG",
-	"hp5BZcl6bflSAxgTSiorVAOP0KJGOadKMZ+wSzE6p0i5pkrc9d/q36Y26kaGNdIvEZJEhjBCNriCTrCE",
-	"/KEO6eVdqH+WoKXvVOdmTlMp479s7ET8JZnEYbkhQlghyygNsIhz6rVovoVt0IRzUT0Lg5QJHYDExLiI",
-	"bpViAlXlfdjLWpowB0p7cYwL7nF9FjgsBD1JpJ8Vd9RGEVLSuUApAiHwxNHl+yTCdJMDDrQeM/NNWxcH",
-	"ObChChsqLGvCVva3c047du2AkFgmYt+58Penp8fINKgsf3fodJM0Yju1gQ5LHrzWqiaAi2QyMU5hvtat",
-	"Fy+ewfbzZ7svt8fPtnAw9l8+ff5yCz/dfgnbF+Ot1uWaXS2tqZbpbGy2LphTGwY4JEIiNs62LAsK2B1a",
-	"TmW/zsHiZrUQwMfY8ry1+/NwgJ5h2dwXMANO5Hz05uTk4wna+/BaBwaJDyMct6NpShQXUd/hZJKacRVq",
-	"4snkbUL9sk8bQUAwrXi1e5MJh4m19BLqZybfRI2QersFR7foZIpEedbG+cSzyV/fgTMtAHaoWic0ocSh",
-	"XM8okZltZMbX7nKJqDFw37j4Veq5ydQaSbytTV3cjDWE7ZaIia0aCltL8EvvUJOZ2mCkujjMHSs5wrFy",
-	"Fhzk0AGSTck2JVzJgc9ChRBp646E+VdhCGd0ZNmQWcpsS8VO9rtGTDrYiso0ajuyM26pIlDZI+3gharu",
-	"86i7e4BvhAZoQ8dAMt9RgIaWJwUkUQ0aY2Wl/heHU0RGxa8cHUxBB/2KOLj9YlFA3+tGylPRM1bQJJxL",
-	"d0Vq3qWBHmekpgM94wyUFiRlHkMOGoWD457HKHwce6PPHQLtC9B30+sQHFjynfeAZYTjJd9yAfPNFyVv",
-	"7PKgjs6cXSrm3SjH8p50I7S24mvYyDxrDA27N30hCJwdB1hvfmpo4/U8g5VOVVjvbB4u4WJ2QIbLPE5q",
-	"hWFru+I762BpSRbQxnBze/eJUfrmOHp7t3A27TRMr/auiIOr/41iJrR+Tcdw7tXc/fZ/urztckktJhbQ",
-	"y76XskUdkp+wy18AyJVcLI3jTdh6wi7boBWuYkyDhXCMy08sxlrVRIlA2buu4Atpkv4VQZbQAK7cy0w5",
-	"asB4oLtzvJ0aEjUoYM9GzUZ0NTwyO8EVQHRDlZpuilS5PfwOKHAcej8QWhpFLp2x2QOXoLk1SI2PWGcV",
-	"6+iKNitz0K0QpLNp29BHB9fFzrSbf9doTRbt9qqbl37tpId5WuQUP07+SmzIo7Is92lNgbZlz3YuJER9",
-	"P076iSRpTkXrim0/Zmr1663za7WJ7wBdbflnR/LmDGNjClfIcG4pAuv94+3bp893dm61tXaQUr8Xcwmi",
-	"m1daCUZ03dos+qCfV/ntfvd6mZBkbSDYjNoSHvKn4H/7kETHjCxkBGwtqpsPGpMQGytHW4CfSDIDFKsX",
-	"BZJTLFGUCIkuOGB/iuSUg5iysHTkt9OceJcm+jgZUbGeRiLJECj/VQGsPW0yEMNBqJnXbVbLCefEnp3V",
-	"n+hmp2u3Os11WHe5ZRLhq70wZJcQaPe5/ugdm1YmitPArlsdj5Rbw4T3GCCMCG0hgj1a70qEjqfqSRiK",
-	"oyx+nEb39CCV4N57ZTEwNMU0CKGYzVXwWdI3xzqx0tNZYzPg7rhdgwLdSHNhzRHnIaaTBE/gSUGzWvC0",
-	"crF4ZINnk41MEp6g82Q43AH0ctguFPd0GNkSDcpwwQlzlhtdaYwmebvt/DplcepODS9FvLq4wwvs3ODU",
-	"pHnV95bXQxqSuxsTYnY6eQM16s8OUNF/78lkivaPz9BZCtHLJaXoDZu/mQGfMwqv5m8iTMKl0jFNDzqx",
-	"KE00j0ARS6CLOQLdnzNFJVOn3UJGLl3cFshxnO6YOA7EgGVRTTX1clJubQ/KHLu0n3AOVGYHdKZZjmYs",
-	"BoUqY8LNcVeMEwGBEsl8Q20bR9gaR93lULVWcpdv0C0lUHKgwV7x6KZ8oAc0QLjutCalR46taCMFK93A",
-	"D1kSoL3jgx5i2RmWIiCE480pExKCJ/1z+hok8IhQEGjKLlPEtinAmEM2AwgQmyn2JNbtkmaCFIdzQUT/",
-	"nO6zKGLUvjtCeDbpIZFEPRQR2kMRvuqh+Omwh+KX+r+n6r+X/XP6gUl9dJ/NC6WaZbbdH/a3EaYBAsxD",
-	"AhwFmvgoEWA88DGBMOiflzWLOaaqDe41ioSh66lq2uxRp/hxf3khLrfbopcNbqXSYYGgQTHVpGD8LNrp",
-	"EbJ/Ach+xM9H/GzET3c0yGJVO0adOg9j1Lfa9cv8vSxTIXOxdKJKyQaoC9H1Slcv7Q1NVySKBsQdO89i",
-	"NFkbBFcxB301baVs8DNj8qZoeqtccEOkfZZQ1+mF+rpAS4ESfVtLc0Y6/Aaj4VzfRlCCnN1AfLLgTdWO",
-	"nSGE03/PrnC6xi4OoYBl/RrFbnhVo7yhE0IB1DahvRBKmaOF/PMMvbvn0dkhaxJECie/aGOGOQGtQNTI",
-	"T1y5IzEnjBPp8NH1rFH6HIUwg1CgjQzXFHr9Sye/pYhzQMeshw7ZZQ8dQUAU9GjFuuFjAZsCqCCSzKCH",
-	"fBwTiUPy3WDgEZ6jC0AQxXKOlHpOwgpWIkKFxNQHsQA2n71DHY4wI3pfHJdd1Ly8XrldT+v8DhdeOibu",
-	"Ly9aJguunqUCCIkyKGyGX4m77LPSta22Q+i034UjaBFi/5vX8y7hYsqY+ktCCBOOI20GSaWemMnKKgC8",
-	"fWmV7N6SsanX1YDkDnCtszxXRFidDm1y84q2aPs9nHUAQtv1lLuEh9E5Pad//PFJbeUff4zQ1+vzlAnO",
-	"eHjujdC5N5UyFqPBQH0p+nrX+z6LBjYrUQz6/f65d/PVdPWneTvtLFnsxRJD92BHOvd66NyL8TxkOFCt",
-	"r/v9/k3a4anlxLTHCyZP2Tegptut7Z3dp89Ge6/2N1+/eatnojrzp1ge6L42t4ZD0+j5i5fDtNOjjKfb",
-	"Vp1zf784dU2MfOXFXb4u9OWN2sl3OrT/Bq+yv/7t+OfdrAO63fbpghVacsgSPgHqz7UFyFkiCZ2sDfXv",
-	"F8TbMbGPPiVxzLhSNuqzEpBN9FXv2tcR0nKC7PaWoUK3s0++jpA+YCd+Q9sUYb+OUMri6IJJR8ucBb+O",
-	"UM65dX3fD653tsNPKg5iQVKE5FjCZK7VmfH+jLItgDqpPbXaT4RkUenMysZJrMGpedw0SkdyJn5lB1fP",
-	"hm4tnc6yeHqS/lUtpqAWnM8qezvfl/xVMzsH97qEvXgN727yt03a5R3lb9/1SbyIMf8WEgqviVDrWOLW",
-	"V/bqQr0HIhQxTGfOS1/th/92QfkR2iqH/64cy/WnpHe45rlMSnoAVIDuc6koWCIA6VctN4Z4zhJ39Qit",
-	"II6BH2cXeNIT/mH9Eb/JvoqBo7iU6lD0On/tAhNpJmm3myJNqUSLxSS6ZtccKlEyyfWrSLKSxD+riaf9",
-	"pz3XOJdp+mlMrkydiAzgxyHD0nVayGLsZ2ZTLdN8NK3QxnDT7HCWzGo3qyGZVcyjC1aO/6b1HsqDfNIN",
-	"tblhLl5hiW3uSbVUhE+4r/eWqx3qeZITTCf6m4DgiNHACb1mMp/I97KY7NbMRZDvUCKoI1+2wiulWxGr",
-	"pGERkd7EWGSaynpYXCYs/F0t38OiGHMiGEVqHrgc5sNUqzf1mtbDoT7ymEj9nzvnWsKVK1UArmQRDhaX",
-	"UntFpeZqiqmko6YOCE8woUI6D3GqdVoE+Iky9T8poDFEvwDMge8lRpDMp7epbPzvn6cVop0J4At1lpBU",
-	"3pa5BG5jwp4tvKTRWHeaT1G5O6a8E1HGui0bhX1NOlvYLO8mKblI2WVymFX1z8mbT6fKVTGRd0zxRDFK",
-	"GqXmtoKVQIT6YRKoZ1lAUnku+TXl/jk9VRuk+tJ5LALNWaLprtMZevYgroe4QkhQbovuAUKQUNcrijlT",
-	"hnWEFeHCcG68m5D4SpUVFn90cFpZOIuBmvn3GZ8M7EtioNrmqb1Z4bi94wOv582AC0Oarf6wPzRiARTH",
-	"xBt5O/1hf0cb5nKqWSG7sX2dBWNuCne3VZOJKxXmxNJAn0oVlqt2AafHVJ4e2pgCB4ENzb7OO18oOrc9",
-	"HC5VT2zh4nFp0p10bl6cpu22VqHzqj6sFi37lPg+CDFOwiyorYbYHW7VTSkjxKBUXk2/tNP+Ul4076bn",
-	"PTV0bH7DVdNOw0USRVjZEyaOHhR3S+KJUNQobOGXNBRlK1HWHDHmTQZ5BUR9UNjCgIP04v7qg/S8mIm6",
-	"+xWAMKJwWSgCoBHNFAYolgMoM7J5N6PDW84i9YqtyAhCvmLBfIGZ9c3CdDk5F3evSLBot90s1n+8uRuB",
-	"WkKM6sSmk9QsVH2CAIlMjkJt6m4bAfo9FoQ2cKhlUGcVm4j3EyPxHeS3UKHzl0MWK3kOmasDmQ5AcV2o",
-	"EntjBEspZsedJ6OwcbGU0twc05SF3DTMmcEtXXVbboZ38fDusq81cMq9bPuumXLzG1lZ0vXxid2poLAD",
-	"NSqoxUZBWXhiYc/RJVE+ohTmjiJXVp8y4NJya2WGeAeyjRvuEZpszbq2NHab5BrlNzLyulWl0nLdK2Wl",
-	"hW/qL9cJQ1g5BcKrA7TdZTthl7W1sA66XaCD8lXxddykW3vhJByT9ppJzcGa5fTRjzVLfxSKvAPZBUJW",
-	"NjB7rY2L5c87Gb0lXTbwQ0bhVjbwslNsNZl9Fs8VuGCK4IoIqfzqIo0XrGW1gJ8IOddl1KllVRX8by5O",
-	"ejPbBWppLtdX8H8qJld+YaYhK2Uea3zCTIM0uYKr10nPFdRdu3+TQvGZjjMqi1XWQRepystg1Pl+P69X",
-	"9MMk0TBqxqINui1xHUfaewVTQBNThVj3VCpFbMJ59Txv+shGe2fKaKzK+FUGXKJqcqUoc+v5aWcJqlMA",
-	"BXrZyxyPXNvKtZbtAgcV16pLBtcpAHWNB+RYr+MRzWhv3iqh/S8PvXUhi4cRZuiCo/dkm/Tqq0TV/SpQ",
-	"zuzL/SxQ5Qj3S7O6KFr8RYq59MKjLXRLgXzUKktqlVYZXlqV5D8DcJ+OiUsAjwktFnlnSLJY+eD5d/YH",
-	"Q8qieEzoqiFss/aHpg5KdF4bH3GwJSV+NBudqIksMFJaSZVxpOeZJ9eVeUm/uyo3mZ4fGDMtUHt97GTD",
-	"7z9htISbHxjqGiw50Vn763EZ11uI8DaVBzuU3Vuff3oLq8YWpex0IuOwadT3nU0aRbfH6M4q0R1TC3J9",
-	"yDG41qVxl3CL1d5hGuh0LyJFzQFp7hSnUv0rm+BcA8aDd4kbmO9He8SmiLPbITbFn+/XFzakqnOFb6fp",
-	"ukH0b6gyHp3gVZzgu1IZg4Bd0vu0PH8uyT9is5JOVNRot3bVW1YmXivy/fIyGbEZBGbxD0wzagYobf/d",
-	"iFkSPwpZrgGWErGz+HcRsCR+2OKVxGsTLpleOP3R8bfCsbtJUSwX4WDjZQ7cT+2VzvWETzr+ym41P/Qu",
-	"fk3W/bOwhXTXu/55VT2f/GD6zrMJDDc8WrsrJxJk95vXAhgJ/XmOfs7UXBbvS3Q7/tGvrhqyT2nw0LTQ",
-	"Ar3XxlPp7a/m6wpKNTiSx9tUQ/F2QnYZrdEIuosbaY8Z5wsZ5433mno/izniTOTudhdywSR5vAu53izz",
-	"R4NgZYOg07VCi9tlBE9v83e7/57d/W+//X6UdrxW9ixOt5tZbAvctv5Kadrxw7r1HuW7lPJNtnG3u/He",
-	"6Rg9rVzbzE7mlaOsVPFdHCyUKrrfOcpG+Q+bdGJeN7N24tXS75Cs/ab5T7KQB3/DPK/j7RDjVvAfXGdl",
-	"prudlKdy23SdvCivbY5YurdLXSVveumBXyRvZIclLpE37/M7kM2b/PODysN121pY5A69tVTVLuer5fN1",
-	"OWWPxsGtdOqj67Wk67Wavi0WKt60P8/Q0fNy/URQFzfMUTt+zS5ZcR2dXDLXb4W0uWfZIA/LPXPueoHp",
-	"iqRE2fbeg+PmmliN3+ba7ruB6drfSbhzyPbzn7xZmvPdnH47Rn8YTk8NE7YJx3LwPLjOfs6tk29UMFk6",
-	"CYl5r05IflsOfQhe2Ir8uYR/5vzhwFpn7ZHJfjcnbmUOu0P3LtW8y7l3nbDSvPdoUDwaFD+JG7iqBVKo",
-	"Vq7lr1in/PMXJTdCD22kc1F4TBHsY86CxPxyiamHXS6pjWOy3S8UFB8Qu6jBbMuRBnvIfBxm1cQP7O/M",
-	"lXodDQahajVlQo62dne2XpT7/HLz/wEAAP//3HiWLbqrAAA=",
+	"H4sIAAAAAAAC/+x9a3PbNhboX8Fwd2adDiPJjpM2mrkf3Lzqe+3G68d2d2pPCpFHEjYkwAKgbTXr/34H",
+	"Lz5EkKJs2Ulr90MnFgEcPM4b5xx8CSKWZowClSIYfwkyzHEKErj+680cUwrJfqz+iEFEnGSSMBqMg5+Z",
+	"JFMSYfUnikw7tP82CAOiPmdYzoMwoDiFYBxExThhwOH3nHCIg7HkOYSBiOaQYgVgyniKZTAOCJWvdoMw",
+	"SAklaZ4G4+0wkIsMzCeYAQ9ubsLgLRbzCcM89s2v+Ng6qbjSfZPTOmSUSMZ9k7KfWqeUFl27JmRhCskJ",
+	"nWmQR5z9FyLpA3mWSY4jQJlp0go6K4bY3F7cqKFExqgAjU4/4vgYfs9BSPVXxKgEqv+JsyyxuDT8r1Dz",
+	"/lIB+ncO02Ac/G1YourQfBXDd5wzC6q+7h9xjLgFdhMG7xmfkDgGev+QC1DoOSJU5NMpiQhQiTLgKRGC",
+	"MCrUlPapBE5xcgL8ErgZ7t4n54AioaEiMA1DRc/vWU7j+5/CMQiW8wgQZRJNNcybMDijOJdzxskf8ABz",
+	"qELTx3SJExIjxpE+ITpD6jtQaeFqKrOjKqB7CXA9t4yzDLgkBsGxlIZx4jgmqh9OjmoNlkh3eVp7UnIy",
+	"ySUIxKZIzgFhBUjNR5IU1KER9c2eG5pxlmdBGMA1TrME9MYAvyQRfLJkjTOiwFi4bKJoXP0QccAS4j3Z",
+	"5Bh6bUg3ULxdARYSpxnaOqPkGqUkSYiAiNFYPKvC3v7+1fcvdn94/Xp3e/fF4PvXOzthyS9ilk8SCIqZ",
+	"0DydgEa8KeFCngBQ31x+mQPV+6DA6R1FV1gg3QexiUbi+J4mRuK2vckp+T0HRGKFIFMCvAptdydsssll",
+	"1hgGCV571Qm+/0Wn7cJr/61DStsIyTm2iAJxia0DtDcRit9NGTe/CE3qruFkgbAbYlCd8oteG2cQ238w",
+	"+ltlxABfzrb+HmX5M3Sej0YvAL0eBWGTCrN2+XlUlZvl7vaaqpBYwioepWd+olsWhOpfnfoWIpFHc4QF",
+	"SkFyEhW8oLZs88230jyLu+leI5lpdc+kf1NVNH4NSByENS3EHqbu5fbywsPKKhvYsibdd4A+ZkAdQl5h",
+	"YhC0TmaSoYn+e0p4CnGoOI2WBqbXHF/COaVa9bUo72jBqrgiRJjGKEqYgNh1wxwQB8GSS4gH51TtHFU6",
+	"068By0D9aaAEYWD6BReVvS0/Ng7zzRxz+YGTeF9CeqQ0d9GUSZFq9P8Itbg9xXmiTiUhVG1rfbv0gOiS",
+	"iBwn5A/L/83+uxnbfpgDDsJggrk5m+gzxM/tj+5P9fHCM+2IJXlKD3HWJSm7SOZQo/cbPUxTiJrfn4sM",
+	"ImWhmNOc5VwvRwQ+YcgohUj+nCeJqO3SFCcCwiZ3lnPgCldsR0TzJEGXOMlBIGJZt9rKEtiEsQSwnm0C",
+	"M6DxqjXqozgwTRVf1ksWHpPCfFCziYnIErxAWym+RtsjRaFEQtpzO/cSgrVemuLrfdNte6QVfPdXsRjM",
+	"OV6opr/nwBcem+OfB0h/KimsvhslLmgWo7WaO+DDaTHIiVwk0ESJskGJFkIukhpdOXxY4ktu591qfSyo",
+	"elgNAkzx9QHQmZx7Dg9fK+sJJfq73i2DHSjBE0hETa8Y+URMluAIUqsol9Q9YVKytEHfZoqo7FTSddGD",
+	"k9lceum2lE0lExGyDYjDxiX+QZnmH7anxEo0+IAZaqqB+1WJ8+BiGd6/DN1JhsScXSn6sxRWQX8HXQ2g",
+	"cTrQeB4YPaxjtQ7XfTp04WLwMN129bro9XAq9oyT+BBf/0LiLiS8IrHFQdUemb2rAN/Z9SGgavtPPxv4",
+	"kLAJThwnIIkEjrQtp0QnQzhJWiAFPk5BOp08nSp5P21NcToqgV/ixLNJxs9hzDBimylcq55VFear0Wg0",
+	"6nU6foW2XFpDqT0xJh76eKn+AVdBWOUxOy9f6rW4v7d9+i6hFOI1RN0cUOEuQ0QgO0BlVrZ/U9z10q2R",
+	"nBNRATGBhNGZIur1z1HzlDd31TFOy1HaVIy6ZmFkneqFSpHR4Bm6wQdltqujqHG3BmNzzZBRmEQhTfUg",
+	"Vfa2gnlZsIdVHaIVqm2FcqXAWmWmPJgG6DUUC++kWnjHP2vqQxN+N6+QkGYJluBDulP7TWEdmVaGVia2",
+	"s1GnnKUIIzdODWBuPKqDmEWfgQ8iRiUmFLho028+TqcCPJJAaSWI6Y9dnKQfF5GMJZJk4o1RSdeibttV",
+	"2ymR649wxJkQRnUTXk22w5gs2dfDGZRhsMBpcsY97Pvs+ECJnCnIaF458v/sHR4gDhkHAVRqMq4d9ZBY",
+	"d+nwcntoqXq4PSwGUH8omEFldjknTUToZetedOkYaqr7NMs9Xkc9g8aS9drKpcYwJZQ0VqgAj9GyRDmn",
+	"SjAfsysxPqdIOUgVuet/q/+ea6VubFDD/YiQJDKBMbIufnSMJZQf9cVSOYT6z25o7Tc1uJnTXMrsk/Xg",
+	"i0+SSZzUGyKEFWcZOze/0JZ1p+RbOga9cb5dL5zx9Y2OQWKSdDh4zXXJkguiaGmdtm4UD1zww41Y7NEQ",
+	"9CSR/lY9UevLdlvnY0opCIFnniF/ylNMn3PAsZZjZr6udRXIvgFSej+qknAl+ts5u4F9JyAklrl44134",
+	"T6enR8g0aCx/d+Q1kzTH9koD9UGJgqk+mUk+mxmjsFzr9g8/vIKd71/tvt6ZvtrG8TR6/fL719v45c5r",
+	"2JlMt1cu1/nnKmtqRTp7Q9jmzGl1AxwQIZV71h1Z4RSwJ7SeyH5bMoub27kAPmYW563eX7oD9Azr6r6A",
+	"S+BELsbvjo8/HqO9n98ie40xxtlqbuo2xbepH3A+c2pc87pmNnuf06hu06YQE0wbVu3ebMZhZjW9nEaF",
+	"yjdTEJy1WzF0q0amyNMgtMYnvpx9+gM4C8ISVKsRmlPiEa5ntOK8NPC1uVzb1Ax4ZEz85u75t2mlJ/Gu",
+	"OnX1MDbgtlvDJ3ZbV9hGnF/6hLrU1A4l1Ydhfl/JIc6UseDZDu0geS7ZcwnXchixRHEI17rnxvyrAsLr",
+	"HVnXZeaQbS3fyZu+HpMeuqJSjVYFjhizVG1Q3SLtYYWq4Uuvux/AZ0JjtKV9IIXtKECzlmcVTqIadPrK",
+	"auM3ru3U7Ks/eQaYg3b6Vfngzg/hstjVjZSlomesWJPwLt3nqfngHD1eT02P/cwKprREKYsMSqZRCV8K",
+	"A0bh4zQY/9rD0b7E+m7CHs6BNfv8BFimOFuzl48x31woemNX+237zNmVQt6tui/vWb+N1lp8CxqZb52u",
+	"Yf+hLzmBi+sAa83Pzd4EYWB45cV6N5cHa5iYPTjDVekntcSwvdOwnVWbOi2grdHznd1nRuiboKid3UqE",
+	"lFcxvd67Jh6s/jfKmDBXkhaG96wW/t7/6dPbZ5JanljhXsX9qzn6Nk5+zK7+BIxc0cXafLyLtx6zq1Ws",
+	"Fa4zTOMld4zPTqz6WtVEiUBFX5/zhXRR/y2ZLKExXPuX6TBqyHgM3N/bKRItXMDejZqD6Kt4FHqCz4Ho",
+	"Z1Vquo5TlfrwB6DAcRJ8RdbSSXJuxuYMfITmlyAtNmKbVqy9K1qtLJluY0N6q7YdY/QwXdrCVLzqZKc2",
+	"ecBmKyznaA7R55/z9IiRpcvS7WVK/Fkfl7KmI0YFRLkkl4Ay1VGYmKc0FxJNOOBojuScg5izJF4KZeqK",
+	"jHUxEB7GqH83hyQZAqXaK9yzjniz+xyEmnkFXhCxnMqtZ8HKq5+ZvVRov+oqrh3udM3lEXuVKDN8vZck",
+	"7ApibVe030li08qYt5V4Hb15VX9xz7u2lNAVgAldC3A/sCvpialY3ITNVPiQczTQmSESLKO5+iNhM8Qh",
+	"YjwWvqNH/0NXc+Cg2n1yHhX0f9A/tFPlH2u6Rs2MCzz10VzVVm66VtzPXh5kvtYWkeWfcutmbOCs/4a0",
+	"ws9qQ4mFkJAOoiwf5JK4OKaVXMaOY6bWvt42X5I2q730zPgS/qCtOVwjgza1W4/gb+/fv/z+xYs7sVMP",
+	"kgaThQTRzxPUcAD2PdrC46e/N3n8w571OrjeevlioD5ywVI5rEcgWpro2lu0rHLNP6BT/o5irrkJ/bZA",
+	"hUGKw+LOxpJBoIE0HOo/KS2doTmmcQLVCMqKn8D1nOqUGi2O6CVwv6+8Q8huuSwoE1ZwgOksxzN4VtFm",
+	"LfO0dLF8TariyAtKqAeTryCKBwoAWOGB7RbkFht9ocMmbW9VzIhDcepPCqx5mfu4oJbQucORcOguIB8q",
+	"lo50pPV1BqHdJbvBAWjIv5/IbI7eHJ2hM8ei1wsE0we2eHcJfMEo/Lh4l2KSrBUCbUbQwXwuxTAFtVlC",
+	"JXqAHs8bFlaI035uWp8sXuU89dyorupykmG6Xo+G6bmqw1mmcHSpj3bpQgZYVqVn1zDH9dbVdJMlyZ9z",
+	"DlQWd/WmWXsOQobzRg6CbeO5wcJpf/agWit2UOLNHRmD5EDjveotbv1uH2hc2lPLF7duP0qWj7YcD9UN",
+	"ooTlMdo72g8RK66z1QZCMn0+Z0JC/GxwTt+CBJ4SCgKp+GMrSGw2AOZQzABiY+ep49cDSTNBipOFIGJw",
+	"Tt+wNGXU9h0jfDlT+T5piFJCQ5Ti6xBlL0chyl7r/71U/3s9OKc/M6mjeIp5ISfwLncGo8GOzkkBzBMC",
+	"HMV681V8n3HGTQkkLi2lVKz1jXWrn7+TUs2+qouZFc412/Ir5xz58owK/tQhL1uisb4VofkkSZ4kyW0l",
+	"yRNbf2LrnWzd7zuzLHQ16zz1XherX7WhbBqNz+lzu+1jd5pq69GS29Q0Eaq1ji0bW46q2k5YLhGFK/NF",
+	"txEZpp3jqQa6ZcJmnQ2Vv1a1yzUZjlEGnLCYRDhJFkgAjQXCFOloRRuMqdNe0NnxQS0JtDS3XaBgZqLC",
+	"mFLHzOh1daw9v7dalsVWb/H5Km2+a4cXr2ijbiE56LIVt8rROTNGkRNsd8rQMYjxhuXUd6esfq7gj0ph",
+	"ME50IgrwW4wmC50jpphXUZ3k2ZK93Qq74IpeD09R3sUHuwri14u7cUi/cLcH3hTu7+iMUAB1TEinRHtz",
+	"FUpB2j+62YJsCdurxOOgrUusi1dMTErgM19EX8YJ40Qu2hK53XeUwCUkAm0VvFxx7H/pkGTHZffplIXo",
+	"gF2F6BBiotit1nG2IizguQAqiHK/hijCGZEq39nw/UO8UDngkGZygRg3nqkl+YAIFRLTCMQSg/01ONAO",
+	"KwMxuPCkIKp5BWG9XajVrx5piPdaqiAX7SgVQ0KUbmfjrqsAAvutJUndHxrkxl0KDBIJjj4HYXAFkzlj",
+	"6l8SEphxnGqNVCqRzEysbEWo2U63ybmo6f16XR3Sy8Nc24yAW3JYnaRiIqarZsHq7MhNMIRVSYP3yR7G",
+	"5/ScfvfdiTrK774bo9++nDskOOPJeTBG58FcykyMh0P1oxjoUx9ELB3aWHExHAwG58HNb2aoX0xvN1i+",
+	"PIrdDD2ChXQehOg8yPAiYThWrb8MBoMbN+CpxUQ34oTJU/YZqBl2e+fF7stX470f3zx/++69nokaLJpj",
+	"ua/Her49GplG3//weuQGPSxwetWqS+wfVKeuN6Nceb0YUDlWMF69facj+9/wx+Jf//b85y0otDbr9uvk",
+	"S5p3zTbO+QxotNBaL2e5uiHfGNd/WCa+micO0EmeZYwrYaP+Flob/k2f2m9jpOkE2eOtswrdzn75bYx0",
+	"2BOJOto6DvvbGDkURxMmPS1LFPxtjErMbRv7Yfh6b9vjuGEUVyhFSI4lzBbBuLB4jbCtMHXSeq/5JheS",
+	"pbVbTeuysgqnxnHTyEHyhuMWV5uvRn4p7WZZvV9z/2oWWlMLLmdV9C7PpexqZufBXh+xN30bT/FWT/FW",
+	"f7Z4K23odwRc6e+doVafjNb4KWIxqFArbcD/A/3PFOZTpk6tHN/GQ7CqRQruJ7vNJKXcU3bbfcdMiQzz",
+	"zwmh8JYItY41cuKLrkvVsIhAsRvMmxK/OkzLLqgMdrhNmJYvA2XzCXs9imCsk7AXAxWgx1zrYkC5NHVX",
+	"i40JXrDcX1tLK2pHwI/wrA5je7QMoJQ6uhPKgKOsFpRW9f78uctvuTybfnm0XYHWy6W2+sZBHihS0t9u",
+	"RcmKEn9ppuUMXoY+OFcuOScj16aKViEzpgnD0icyWIajwnxpRZqPppVK9dke1VJ97GF1pPqIRTph9Ssx",
+	"Vw2rDuREN9Rqvz7BGEts1aFmIa2I8EifLVcnFAaSE0xn+peY4JTR2Mt6zWROyB91MtltmYsgf0BtQz3Z",
+	"RA1c8V1PNfBlwuJFS4q/c5rrJj7xoVTMul5ll/Fq5K17rHSrCcgrAHXRCtFnUdHU61pWl27+wnu8cJ3p",
+	"uiknRZb/0v3ZzmjUuEI7KasY6JK/WJX3jECIaZ6YKdYM053RKNwZ7V40S/90+IqnTOlWxxAThSKifyIS",
+	"Q6Yr4kVfH8+dA47hTmWPa6fthqs5MvZstWbsqnsD5nqKn4F63REpyDmry/vgw7vToBO07VTSmOnx07s9",
+	"5VE9+nii/jo60//fO33zUxAGb98dvDt9F4TBx6PT/Y8/n/jNf5ICy+UK3uLqptjWLai53YmaXtsn76iI",
+	"08Axr39sDjjRxeLXqnKj4PpkSC2X/DaB9ES4/PVlYdLYepbVcQB+bxY9ZWmGORGMIjUPbAJzixBUqtVe",
+	"1U2bgImEIAxmUv/Pn6mq0ux9Kf3XsqomLC+lNbG/JaFfn56eurqKxoQK6Y13aVa3VCY2J3JxohQQy4U1",
+	"PSkqK/967876//7SpJszAXypRrqhRlM6y95TB7ZouuYYetByigrPTGl2QqfMlXzHkd46W728HCavuTCL",
+	"Elxw2eQmx+9OTpUrUe9wiilWFV3cjBC31ecV64+SPFbfigtD5VksizsNzumpOiA1lrZPBVqwXO+7DkgN",
+	"bcxSiDhITkC5FfUIkICEtlFRxplyfKVYmqti431MSARUQGXxh/unjYWzDKiZ/4Dx2dB2EkPVtkyIdPum",
+	"Zh6EwSVwYbZmezAajAxZAMUZCcbBi8Fo8EI7zuRco0JR5+pLcVlyU6l4pZrMfMHMx3YP1FZVl6tOAbuI",
+	"nkCDNibCfmyvTt+Wgy89GLEzGq31FsBSuabapHvp4sVUVta4qAze5HHNBwdOSrHuVqhA7I6226ZUbMSw",
+	"9jSC7vRidafywYubMHg5Gq3u4XuPQrOLPE0xX9jDqhytTuCfCbUblSO8cFdF9hWZlmisssmwfL1EBy+t",
+	"QMChK3d2eyBhkDHRlpUOCOuIkQKi4WimnFq1iFodkU3fYh/ec5aqLvY1FRDyR6voVpBZ12NxyymxuH8d",
+	"t2X5e7P8dsvN/RDUGmTURja9qGapVi7EFfU40crtzmj7r7MgtIUTTYPaAW58i88Mxfeg38rrOn86zmIp",
+	"z0NzbUymB6P4Unnh6cYQlhLMnkoR+neEK/AnCxNGUSdy07BEBj91tR25Ae/D4d11u3VgyoMc++5od3WP",
+	"4kmhzeGJPam4cgItImiFjoIKt+XSmaMronxHUpjKLpxdGQXOFamuI8QHkKuw4QFZk630vSoR0aYppWVO",
+	"bVntt1aQu399YVcutL0kiTAbK+dAeBPAqgogqr5MWwXh/X5lR6BeYGsT9Uc2Xm4WZ2R1pdluo3s9efR1",
+	"1dKvxUU+gOzDQm6tYIYrG1efLry5WFeWDaOEUbiTDrzuFFeqzBHLFtqDqcLZiNC3qdU9XtKW1QK+Ic65",
+	"KaVOLasp4P/i5KQPczVBrY3lSqh8W0iu7MJCQjaK47fYhIUE6TIFb//GYSmg7tv8m1VKdvacUZ2sigH6",
+	"UFVZPLDN9vt2raKvRokGUQsU7ZBtuS9MwaZgqpq15u0WPVLtARfjzmvHeTNGAe2DKT54W8RvIuAab800",
+	"nrJZGVfRm4LaBEBlv2ze6xPWrsRai3axZxc3KkuGXxwD6usPKHm99kd0c3vTq8bt//Sst81l8TjcDH34",
+	"6APpJmF7bd22F71LZF/vSe9GaMdFt7ioavzVHfPJhSdd6I4E+SRV1pQqK2l4bVFSPp72kIaJjwCPCK1I",
+	"TcmQZJmywcvf7DOLdVI8IvS2Lmyz9scmDmr7vDE84mCLgn1tNDpWE1lCJPf+BONIz7MMuq3jku57W2wy",
+	"Iz8yZFra7c2hk3W/f4PeEm6eZe3rLDnWWXWbMRk3W779LvXaexQr35x9egetxpby73Uj49Fp1O+9VRq1",
+	"b0/endt4d0wF/c1xjuEX/aDIGmaxOjt1MarCvYgULRekpVHsqPrPrIJzzTAevUncgXxf2yI2T9/4DWKN",
+	"4Q9sC5utajOF7ybp+rHov6DIeDKCb2ME35fIGMbsij6k5vltUf4hu6zJRLUbq7Vd1cvSxFu1fX96mkzZ",
+	"JcRm8Y9MMmoEqB3//ZBZnj0RWSkB1iKxs+yvQmB59rjJK882RlzSJaJ/bf9b5dpdz2mpSJZOC+194X5q",
+	"U7034z7xlxUrgKFq8Z1afGhZOGBz5QcaiYQaSiXc9Tbp9t6Q0pYgAz2f8mL63qMJDDY8abu3DiQo6h5s",
+	"hGHk9Nu5+jlTc1nOl+h3/aO73tZl7/bgsUmhpf3eGE657K/udAUlGjzB46tEQzU7oUhG61SC7iMj7Sni",
+	"fCnivDOvKfxW1BFvIHe/XMglleQpF3KzUeZPCsGtFYJeaYWWb9c5ODZVcnuxatPWcGc7RPnWQOLiU0Fn",
+	"nLma4eoqxb090kyRL2r0LjGHpepEqtQkB5lz6ubgbtdU1W1dbKJ8eU71cLqsNeGLIudBp9neVW7kJuwx",
+	"K0IRo7rWv5yDMAmMthZaluj344zrwDdHt0nl/Hqp/XoHT3Rfj9YvTDErvVTNGTfIKUrM6T/RleUH7KB3",
+	"yvH6i+cU63RH7AjHUbulpLtXKfByCUtAPatkuNY9amQcuoE3iprV6fYznk2HlehZDPy4amOk5Sk5fCsO",
+	"7m4Y1yvYxsmSbnQyXQ4LQXAf14+1J7LuXRdLywcseyGvH1l74WrtvcmN16P4Rhby6OtQlFqSh4xXMv/h",
+	"l0KP6hdP4+i2q+hElV5XuWvc2a5VcKKr0yMvN9GJDmuUmug+5w8guw/522cqj9e5swJF7tGnc1jYbOt4",
+	"dMr5+lw3T8rBnWTqk4NmTQfN5uTtEEdKRt3zFU0PmjsGkaeK+5vnlFsJbk/NF99OvmPb99HdxrhN2yTi",
+	"mHP6+ohzpOZRUQoFQ0QiIVkmijdN6cz4C4Hq+qXLT4UtpXupAW+DXhZzH1uyl97/2yFW9SCe22cfe/qC",
+	"fK9A93EMed6k27CTqLqOXk4iz5RWOowKII/LYeQ99QrSVbcSFcf7AK4k38RaPEm+474fxbH1/cV7VyKj",
+	"8indtTHfj+lPrvvVbpgWJFxFHOux5+GX4sX+Xt6aihHVi0hMvzYi+cti6GPwC90SP9fwGPkgtLuPnpDs",
+	"r+ZWujWG3aMN4yTveg6nXrzS9HtSKJ4Uim/EMXVbDaTyyoqmv+r7Kr9eKLoRGrQvisc93nHEWZyblxjN",
+	"Ox71p0BwRnYGlYdQhsQuani57UnfOWARTopXUPbt+/W1UcfDYaJazZmQ4+3dF9s/1Me8uPn/AwBuN5D8",
+	"LsgAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
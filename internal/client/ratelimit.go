@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRequestsPerSecond is the request rate used by the provider when none is configured.
+	DefaultRequestsPerSecond = 10
+	// DefaultRateLimitBurst is the burst size used by the provider when none is configured.
+	DefaultRateLimitBurst = 10
+
+	// rateLimitFloorRatio is the lowest fraction of the configured rate the limiter backs off to.
+	rateLimitFloorRatio = 0.05
+	// rateLimitRecoveryRatio is the fraction of the configured rate regained after each successful response.
+	rateLimitRecoveryRatio = 0.05
+)
+
+// rateLimiter is a token-bucket rate limiter shared by all requests of a Client.
+//
+// The rate adapts to the server: each 429 response halves it (down to a floor)
+// and pauses all requests for the Retry-After period, and every successful
+// response recovers a small step towards the configured rate.
+type rateLimiter struct {
+	mu           sync.Mutex
+	maxRate      float64
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+
+	now func() time.Time
+}
+
+// newRateLimiter creates a limiter allowing requestsPerSecond with the given burst.
+// It returns nil when requestsPerSecond is not positive, which disables limiting.
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(requestsPerSecond)))
+	}
+
+	l := &rateLimiter{
+		maxRate: requestsPerSecond,
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		now:     time.Now,
+	}
+	l.last = l.now()
+	return l
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available. Otherwise it returns how long to wait before trying again.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// refill adds the tokens accumulated since the last call.
+func (l *rateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	if elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+	}
+}
+
+// Observe adapts the rate to a response status code and its Retry-After delay.
+func (l *rateLimiter) Observe(statusCode int, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		l.rate = math.Max(l.rate/2, l.maxRate*rateLimitFloorRatio)
+		l.tokens = 0
+		if until := now.Add(retryAfter); retryAfter > 0 && until.After(l.blockedUntil) {
+			l.blockedUntil = until
+		}
+	case statusCode >= 200 && statusCode < 300:
+		l.rate = math.Min(l.rate+l.maxRate*rateLimitRecoveryRatio, l.maxRate)
+	}
+}
+
+// Rate returns the current request rate in requests per second.
+func (l *rateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// rateLimitTransport waits for the rate limiter before each request attempt
+// and reports the response back to it.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rateLimiter
+}
+
+// RoundTrip waits for a token and then executes the request.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter, _ := parseRetryAfter(resp.Header.Get("Retry-After"), t.limiter.now())
+	t.limiter.Observe(resp.StatusCode, min(retryAfter, maxRetryAfter))
+
+	return resp, nil
+}
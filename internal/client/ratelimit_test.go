@@ -0,0 +1,148 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// TestRateLimit_ThrottlesRequests tests that requests beyond the burst wait for tokens.
+func TestRateLimit_ThrottlesRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{RequestsPerSecond: 20, RateLimitBurst: 1})
+
+	start := time.Now()
+	for range 5 {
+		if _, err := c.ListMonitors(context.Background()); err != nil {
+			t.Fatalf("ListMonitors failed: %v", err)
+		}
+	}
+
+	// The first request uses the burst, the other four wait 50ms each
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected requests to be throttled to 20/s, 5 requests took %s", elapsed)
+	}
+}
+
+// TestRateLimit_SharedAcrossGoroutines tests that concurrent callers share one bucket.
+func TestRateLimit_SharedAcrossGoroutines(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{RequestsPerSecond: 50, RateLimitBurst: 2})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.ListMonitors(context.Background()); err != nil {
+				t.Errorf("ListMonitors failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Two requests use the burst, the other eight wait 20ms each
+	if elapsed := time.Since(start); elapsed < 120*time.Millisecond {
+		t.Errorf("Expected concurrent requests to share the limit, 10 requests took %s", elapsed)
+	}
+	if got := requests.Load(); got != 10 {
+		t.Errorf("Expected 10 requests, got %d", got)
+	}
+}
+
+// TestRateLimit_SharedAcrossProjects tests that clients for other projects share the bucket and its backoff.
+func TestRateLimit_SharedAcrossProjects(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 1, RequestsPerSecond: 20, RateLimitBurst: 1})
+	projectClient := c.ForProject(7)
+
+	// A 429 answered to the project client slows down the default client too
+	if _, err := projectClient.ListMonitors(context.Background()); err != nil {
+		t.Fatalf("ListMonitors failed: %v", err)
+	}
+	if got, want := c.RequestRate(), projectClient.RequestRate(); got != want || got >= 20 {
+		t.Fatalf("Expected both clients to back off to the same rate, got %v and %v", got, want)
+	}
+
+	start := time.Now()
+	for i := range 4 {
+		apiClient := c
+		if i%2 == 1 {
+			apiClient = projectClient
+		}
+		if _, err := apiClient.ListMonitors(context.Background()); err != nil {
+			t.Fatalf("ListMonitors failed: %v", err)
+		}
+	}
+
+	// Alternating between projects still waits for tokens from the one bucket
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected requests to all projects to share the limit, 4 requests took %s", elapsed)
+	}
+}
+
+// TestRateLimit_AdaptsOnTooManyRequests tests that a 429 response lowers the request rate.
+func TestRateLimit_AdaptsOnTooManyRequests(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 1, RequestsPerSecond: 100})
+	if got := c.RequestRate(); got != 100 {
+		t.Fatalf("Expected initial rate 100, got %v", got)
+	}
+
+	if _, err := c.ListMonitors(context.Background()); err != nil {
+		t.Fatalf("ListMonitors failed: %v", err)
+	}
+
+	// Halved by the 429, then one recovery step from the successful retry
+	if got := c.RequestRate(); got != 55 {
+		t.Errorf("Expected rate 55 after a 429 and a success, got %v", got)
+	}
+}
+
+// TestRateLimit_Disabled tests that rate limiting is off unless configured.
+func TestRateLimit_Disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{})
+	if got := c.RequestRate(); got != 0 {
+		t.Errorf("Expected rate limiting to be disabled, got %v", got)
+	}
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries used by the provider when none is configured.
+	DefaultMaxRetries = 3
+	// DefaultRequestTimeout is the per-request timeout used by the provider when none is configured.
+	DefaultRequestTimeout = 60 * time.Second
+
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+
+	// maxRetryAfter caps the wait requested by a Retry-After header.
+	maxRetryAfter = 2 * time.Minute
+)
+
+// retryTransport is an http.RoundTripper that retries failed requests with
+// exponential backoff and jitter.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT, DELETE) are retried on transport
+// errors, 429 and 5xx responses except 501 Not Implemented, which a retry cannot fix. Other requests, such as POST, are only
+// retried when the connection could not be established, so the server never saw them.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+// newRetryTransport wraps next with retries.
+func newRetryTransport(next http.RoundTripper, cfg Config) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	t := &retryTransport{
+		next:       next,
+		maxRetries: max(cfg.MaxRetries, 0),
+		waitMin:    cfg.RetryWaitMin,
+		waitMax:    cfg.RetryWaitMax,
+	}
+	if t.waitMin <= 0 {
+		t.waitMin = defaultRetryWaitMin
+	}
+	if t.waitMax < t.waitMin {
+		t.waitMax = max(defaultRetryWaitMax, t.waitMin)
+	}
+
+	return t
+}
+
+// RoundTrip executes the request, retrying it when allowed.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := prepareAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) || !canReplayBody(req) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Debug(ctx, "Retrying Uptrace API request", map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"status":  statusCodeOf(resp),
+			"error":   errorString(err),
+			"wait":    wait.String(),
+		})
+
+		// Release the failed attempt before waiting
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// prepareAttempt clones the request for an attempt, rewinding the body.
+func prepareAttempt(req *http.Request, attempt int) (*http.Request, error) {
+	attemptReq := req.Clone(req.Context())
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	return attemptReq, nil
+}
+
+// shouldRetry reports whether a failed attempt may be retried.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// Never retry once the caller gave up
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method) || isConnectionError(err)
+	}
+
+	if !isIdempotent(req.Method) {
+		return false
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusNotImplemented:
+		return false
+	default:
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header takes precedence over the exponential backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, maxRetryAfter)
+		}
+	}
+
+	wait := t.waitMin << attempt
+	if wait <= 0 || wait > t.waitMax {
+		wait = t.waitMax
+	}
+
+	// Equal jitter: wait between half and the full backoff
+	half := wait / 2
+	return half + rand.N(half+1) //nolint:gosec // Jitter does not need a secure random source
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// isIdempotent reports whether requests with the given method can safely be repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConnectionError reports whether err happened before the request reached the server.
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// canReplayBody reports whether the request body can be sent again.
+func canReplayBody(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// timeoutTransport applies the per-request timeout to each attempt. It sits below
+// the rate limiter, so time spent waiting for a token does not count against it.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// RoundTrip executes the request with the timeout, which lasts until the response body is closed.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody releases the per-request timeout once the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the request context.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func statusCodeOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,275 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newRetryTestClient(t *testing.T, server *httptest.Server, cfg client.Config) *client.Client {
+	t.Helper()
+
+	cfg.Endpoint = server.URL
+	cfg.Token = "test-token"
+	cfg.ProjectID = 1
+	if cfg.RetryWaitMin == 0 {
+		cfg.RetryWaitMin = time.Millisecond
+		cfg.RetryWaitMax = 5 * time.Millisecond
+	}
+
+	c, err := client.New(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func writeMonitorsResponse(t *testing.T, w http.ResponseWriter) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{"monitors": []interface{}{}}); err != nil {
+		t.Fatalf("Failed to encode response: %v", err)
+	}
+}
+
+// TestRetry_GetRetriedOnServerError tests that GET requests are retried on 500/502/503.
+func TestRetry_GetRetriedOnServerError(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch attempts.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 3:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			writeMonitorsResponse(t, w)
+		}
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 3})
+	if _, err := c.ListMonitors(context.Background()); err != nil {
+		t.Fatalf("ListMonitors failed: %v", err)
+	}
+
+	if got := attempts.Load(); got != 4 {
+		t.Errorf("Expected 4 attempts, got %d", got)
+	}
+}
+
+// TestRetry_NotImplementedNotRetried tests that 501 responses are returned without retrying.
+func TestRetry_NotImplementedNotRetried(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusNotImplemented)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 3})
+	if _, err := c.ListMonitors(context.Background()); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("Expected 1 attempt, got %d", got)
+	}
+}
+
+// TestRetry_GivesUpAfterMaxRetries tests that the last error is returned once retries are exhausted.
+func TestRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 2})
+	_, err := c.ListMonitors(context.Background())
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503 APIError, got %v", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("Expected 3 attempts, got %d", got)
+	}
+}
+
+// TestRetry_PostNotRetriedOnServerError tests that POST requests are not retried once the server responded.
+func TestRetry_PostNotRetriedOnServerError(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 3})
+	_, err := c.CreateNotificationChannel(context.Background(), generated.NotificationChannelInput{
+		Name: "test",
+		Type: generated.NotificationChannelInputTypeWebhook,
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("Expected 1 attempt, got %d", got)
+	}
+}
+
+// TestRetry_PostRetriedOnConnectionError tests that POST requests are retried when the connection failed.
+func TestRetry_PostRetriedOnConnectionError(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input generated.NotificationChannelInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		bodies = append(bodies, input.Name)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"channel": map[string]interface{}{"id": 1, "name": input.Name, "type": "webhook", "status": "delivered", "projectId": 1},
+		}); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	// Fail the first dial, then pass through to the test server
+	var dials atomic.Int32
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if dials.Add(1) == 1 {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	c := newRetryTestClient(t, server, client.Config{
+		MaxRetries: 3,
+		HTTPClient: &http.Client{Transport: transport},
+	})
+	channel, err := c.CreateNotificationChannel(context.Background(), generated.NotificationChannelInput{
+		Name: "retried",
+		Type: generated.NotificationChannelInputTypeWebhook,
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationChannel failed: %v", err)
+	}
+
+	if channel.Name != "retried" {
+		t.Errorf("Expected channel name 'retried', got %s", channel.Name)
+	}
+	if len(bodies) != 1 || bodies[0] != "retried" {
+		t.Errorf("Expected the request body to be replayed once, got %v", bodies)
+	}
+}
+
+// TestRetry_HonoursRetryAfter tests that the Retry-After header sets the wait before the next attempt.
+func TestRetry_HonoursRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 1})
+
+	start := time.Now()
+	if _, err := c.ListMonitors(context.Background()); err != nil {
+		t.Fatalf("ListMonitors failed: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait at least 1s for Retry-After, waited %s", elapsed)
+	}
+}
+
+// TestRetry_RequestTimeout tests that slow responses fail after the per-request timeout.
+func TestRetry_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{RequestTimeout: 50 * time.Millisecond})
+
+	_, err := c.ListMonitors(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded error, got %v", err)
+	}
+}
+
+// TestRetry_RequestTimeoutExcludesRateLimitWait tests that waiting for the rate limiter
+// does not count against the per-request timeout.
+func TestRetry_RequestTimeoutExcludesRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeMonitorsResponse(t, w)
+	}))
+	defer server.Close()
+
+	// Every request after the first waits 200ms for a token, longer than the timeout
+	c := newRetryTestClient(t, server, client.Config{
+		RequestTimeout:    50 * time.Millisecond,
+		RequestsPerSecond: 5,
+		RateLimitBurst:    1,
+	})
+
+	for range 3 {
+		if _, err := c.ListMonitors(context.Background()); err != nil {
+			t.Fatalf("ListMonitors failed: %v", err)
+		}
+	}
+}
+
+// TestRetry_StopsWhenContextCanceled tests that no retries happen after the caller cancels.
+func TestRetry_StopsWhenContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(t, server, client.Config{MaxRetries: 3})
+	if _, err := c.ListMonitors(ctx); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("Expected 1 attempt, got %d", got)
+	}
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+const channelResourceType = "uptrace_notification_channel"
+
+// stringType and stringMapType are the variable types of channel secrets.
+var (
+	stringType    = hclwrite.TokensForIdentifier("string")
+	stringMapType = hclwrite.TokensForFunctionCall("map", hclwrite.TokensForIdentifier("string"))
+)
+
+// exportChannels writes a resource for every notification channel and returns how many were exported.
+func (e *exporter) exportChannels(ctx context.Context) (int, error) {
+	channels, err := e.client.ListNotificationChannels(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Id < channels[j].Id })
+
+	for i := range channels {
+		e.writeChannel(&channels[i])
+	}
+	return len(channels), nil
+}
+
+// writeChannel writes the resource block of a notification channel.
+// Credentials are moved to sensitive variables instead of being written inline.
+func (e *exporter) writeChannel(channel *generated.NotificationChannel) {
+	name := e.resourceName(channelResourceType, channel.Name, channel.Id)
+	e.channelNames[channel.Id] = name
+
+	body := e.newResource(e.channels, channelResourceType, name, channel.Id)
+	body.SetAttributeValue("name", cty.StringVal(channel.Name))
+	body.SetAttributeValue("type", cty.StringVal(string(channel.Type)))
+	if channel.Condition != nil && *channel.Condition != "" {
+		body.SetAttributeValue("condition", cty.StringVal(*channel.Condition))
+	}
+	if channel.Priority != nil && len(*channel.Priority) > 0 {
+		priorities := make([]cty.Value, len(*channel.Priority))
+		for i, p := range *channel.Priority {
+			priorities[i] = cty.StringVal(string(p))
+		}
+		body.SetAttributeValue("priority", cty.ListVal(priorities))
+	}
+
+	params := channel.Params
+	secret := func(key, param string) hclwrite.Tokens {
+		return e.addSecret(name+"_"+key, stringType, stringOrNull(params[param]))
+	}
+
+	switch channel.Type {
+	case generated.NotificationChannelTypeSlack, generated.NotificationChannelTypeMattermost:
+		body.SetAttributeRaw(string(channel.Type), hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			objectAttr("webhook_url", secret("webhook_url", "webhookUrl")),
+		}))
+	case generated.NotificationChannelTypeTelegram:
+		attrs := []hclwrite.ObjectAttrTokens{
+			objectAttr("bot_token", secret("bot_token", "botToken")),
+		}
+		if chatID := chatIDOrNull(params["chatId"]); !chatID.IsNull() {
+			attrs = append(attrs, objectAttr("chat_id", hclwrite.TokensForValue(chatID)))
+		}
+		body.SetAttributeRaw("telegram", hclwrite.TokensForObject(attrs))
+	case generated.NotificationChannelTypeWebhook:
+		body.SetAttributeRaw("webhook", hclwrite.TokensForObject(e.webhookAttrs(name, params)))
+	default:
+		// Channel types without a typed block keep their raw params, all of which are secret.
+		values := make(map[string]cty.Value, len(params))
+		for key, value := range params {
+			values[key] = cty.StringVal(fmt.Sprint(value))
+		}
+		var value cty.Value
+		if len(values) == 0 {
+			value = cty.MapValEmpty(cty.String)
+		} else {
+			value = cty.MapVal(values)
+		}
+		body.SetAttributeRaw("params", e.addSecret(name+"_params", stringMapType, value))
+	}
+}
+
+// webhookAttrs returns the attributes of a webhook block. The URL and headers are secrets.
+func (e *exporter) webhookAttrs(name string, params map[string]any) []hclwrite.ObjectAttrTokens {
+	attrs := []hclwrite.ObjectAttrTokens{
+		objectAttr("url", e.addSecret(name+"_url", stringType, stringOrNull(params["url"]))),
+	}
+
+	if payload, ok := params["payload"]; ok && payload != nil {
+		// The provider stores the payload as compact JSON, so the same encoding plans no changes.
+		if encoded, err := json.Marshal(payload); err == nil {
+			attrs = append(attrs, objectAttr("payload", hclwrite.TokensForValue(cty.StringVal(string(encoded)))))
+		}
+	}
+
+	if headers, ok := params["headers"].(map[string]any); ok && len(headers) > 0 {
+		values := make(map[string]cty.Value, len(headers))
+		for key, value := range headers {
+			values[key] = cty.StringVal(fmt.Sprint(value))
+		}
+		attrs = append(attrs, objectAttr("headers", e.addSecret(name+"_headers", stringMapType, cty.MapVal(values))))
+	}
+
+	return attrs
+}
+
+// objectAttr returns an object attribute with the given name and value tokens.
+func objectAttr(name string, value hclwrite.Tokens) hclwrite.ObjectAttrTokens {
+	return hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: value}
+}
+
+// stringOrNull converts a decoded JSON param to a string value, or null when it is missing or empty.
+func stringOrNull(value any) cty.Value {
+	if s, ok := value.(string); ok && s != "" {
+		return cty.StringVal(s)
+	}
+	return cty.NullVal(cty.String)
+}
+
+// chatIDOrNull converts a decoded Telegram chat ID param (number or string) to a string value,
+// or null when it is missing or empty.
+func chatIDOrNull(value any) cty.Value {
+	if v, ok := value.(float64); ok {
+		return cty.StringVal(strconv.FormatInt(int64(v), 10))
+	}
+	return stringOrNull(value)
+}
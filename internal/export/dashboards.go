@@ -0,0 +1,67 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+const dashboardResourceType = "uptrace_dashboard"
+
+// exportDashboards writes a resource for every dashboard, with its YAML definition in a
+// sidecar file below dashboardsDir, and returns how many dashboards were exported.
+func (e *exporter) exportDashboards(ctx context.Context) (int, error) {
+	dashboards, err := e.client.ListDashboards(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(dashboards, func(i, j int) bool { return dashboards[i].Id < dashboards[j].Id })
+
+	for i := range dashboards {
+		if err := e.writeDashboard(ctx, &dashboards[i]); err != nil {
+			return 0, err
+		}
+	}
+	return len(dashboards), nil
+}
+
+// writeDashboard fetches the YAML of a dashboard and writes its resource block.
+func (e *exporter) writeDashboard(ctx context.Context, dashboard *generated.Dashboard) error {
+	yamlContent, err := e.client.GetDashboardYAML(ctx, dashboard.Id)
+	if err != nil {
+		return fmt.Errorf("failed to export dashboard %d: %w", dashboard.Id, err)
+	}
+
+	name := e.resourceName(dashboardResourceType, dashboard.Name, dashboard.Id)
+	yamlPath := path.Join(dashboardsDir, name+".yaml")
+	e.sidecars[yamlPath] = []byte(yamlContent)
+
+	body := e.newResource(e.dashboards, dashboardResourceType, name, dashboard.Id)
+	body.SetAttributeRaw("yaml", hclwrite.TokensForFunctionCall("file", tokensForModulePath(yamlPath)))
+	if dashboard.Pinned != nil && *dashboard.Pinned {
+		body.SetAttributeValue("pinned", cty.True)
+	}
+	return nil
+}
+
+// tokensForModulePath returns the template "${path.module}/<rel>".
+// hclwrite escapes interpolations in string values, so the tokens are built by hand.
+func tokensForModulePath(rel string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenTemplateInterp, Bytes: []byte(`${`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`path`)},
+		{Type: hclsyntax.TokenDot, Bytes: []byte(`.`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`module`)},
+		{Type: hclsyntax.TokenTemplateSeqEnd, Bytes: []byte(`}`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte("/" + rel)},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+}
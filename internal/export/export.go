@@ -0,0 +1,332 @@
+// Package export writes the monitors, dashboards and notification channels of an
+// Uptrace project as Terraform configuration with matching import blocks.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// Output file names, relative to Options.Dir.
+const (
+	channelsFile   = "notification_channels.tf"
+	monitorsFile   = "monitors.tf"
+	dashboardsFile = "dashboards.tf"
+	importsFile    = "imports.tf"
+	variablesFile  = "variables.tf"
+	secretsFile    = "secrets.auto.tfvars"
+	gitignoreFile  = ".gitignore"
+	dashboardsDir  = "dashboards"
+)
+
+// fileHeader is written at the top of every generated Terraform file.
+const fileHeader = "# Generated by terraform-provider-uptrace export.\n"
+
+// Options configures an export.
+type Options struct {
+	// Dir is the directory the configuration is written to. It is created when missing.
+	Dir string
+	// SetProjectID sets project_id on every resource and prefixes import IDs with the project ID.
+	SetProjectID bool
+	// WriteSecrets writes the secrets read from the API, such as webhook URLs and uptime check
+	// headers, in plaintext to secrets.auto.tfvars and lists that file in .gitignore.
+	// Otherwise the sensitive variables are declared without a value.
+	WriteSecrets bool
+}
+
+// Result summarizes an export.
+type Result struct {
+	Monitors             int
+	Dashboards           int
+	NotificationChannels int
+	// Secrets is the number of sensitive variables declared in variables.tf.
+	Secrets int
+	// SecretsFile is the file holding the secret values relative to Options.Dir,
+	// or empty when Options.WriteSecrets is false or there are no secrets.
+	SecretsFile string
+	// Files lists the written files relative to Options.Dir.
+	Files []string
+}
+
+// importTarget is a resource address with the ID Terraform imports it from.
+type importTarget struct {
+	resourceType string
+	name         string
+	id           string
+}
+
+// variable is a sensitive input variable holding a secret read from the API.
+type variable struct {
+	name  string
+	typ   hclwrite.Tokens
+	value cty.Value
+}
+
+// exporter collects the generated configuration before it is written.
+type exporter struct {
+	client *client.Client
+	opts   Options
+
+	channels   *hclwrite.File
+	monitors   *hclwrite.File
+	dashboards *hclwrite.File
+
+	// channelNames maps channel IDs to their resource names, so monitors can reference them.
+	channelNames map[int64]string
+	names        map[string]nameSet
+	imports      []importTarget
+	variables    []variable
+	// sidecars maps paths relative to Options.Dir to file contents.
+	sidecars map[string][]byte
+}
+
+// Run exports every monitor, dashboard and notification channel of the client's project to opts.Dir.
+// It refuses to overwrite existing files.
+func Run(ctx context.Context, c *client.Client, opts Options) (*Result, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("output directory is required")
+	}
+
+	e := &exporter{
+		client:       c,
+		opts:         opts,
+		channels:     hclwrite.NewEmptyFile(),
+		monitors:     hclwrite.NewEmptyFile(),
+		dashboards:   hclwrite.NewEmptyFile(),
+		channelNames: make(map[int64]string),
+		names:        make(map[string]nameSet),
+		sidecars:     make(map[string][]byte),
+	}
+
+	result := &Result{}
+	var err error
+
+	// Channels go first so monitors can reference them by address.
+	if result.NotificationChannels, err = e.exportChannels(ctx); err != nil {
+		return nil, err
+	}
+	if result.Monitors, err = e.exportMonitors(ctx); err != nil {
+		return nil, err
+	}
+	if result.Dashboards, err = e.exportDashboards(ctx); err != nil {
+		return nil, err
+	}
+
+	files := e.files()
+	if err := writeFiles(opts.Dir, files); err != nil {
+		return nil, err
+	}
+
+	for name := range files {
+		result.Files = append(result.Files, name)
+	}
+	result.Secrets = len(e.variables)
+	if _, ok := files[secretsFile]; ok {
+		result.SecretsFile = secretsFile
+		updated, err := ignoreFile(opts.Dir, secretsFile)
+		if err != nil {
+			return nil, err
+		}
+		if updated {
+			result.Files = append(result.Files, gitignoreFile)
+		}
+	}
+	sort.Strings(result.Files)
+
+	return result, nil
+}
+
+// resourceName returns a unique resource name for an object of the given resource type.
+func (e *exporter) resourceName(resourceType, name string, id int64) string {
+	names, ok := e.names[resourceType]
+	if !ok {
+		names = make(nameSet)
+		e.names[resourceType] = names
+	}
+	return names.unique(name, id)
+}
+
+// newResource appends a resource block to file and records its import block.
+func (e *exporter) newResource(file *hclwrite.File, resourceType, name string, id int64) *hclwrite.Body {
+	body := file.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	block := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+
+	importID := fmt.Sprint(id)
+	if e.opts.SetProjectID {
+		importID = fmt.Sprintf("%d/%d", e.client.ProjectID(), id)
+		block.SetAttributeValue("project_id", cty.NumberIntVal(e.client.ProjectID()))
+	}
+	e.imports = append(e.imports, importTarget{resourceType: resourceType, name: name, id: importID})
+
+	return block
+}
+
+// addSecret declares a sensitive variable holding value and returns a reference to it.
+func (e *exporter) addSecret(name string, typ hclwrite.Tokens, value cty.Value) hclwrite.Tokens {
+	e.variables = append(e.variables, variable{name: name, typ: typ, value: value})
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// files renders the collected configuration. Empty files are left out.
+func (e *exporter) files() map[string][]byte {
+	files := make(map[string][]byte, len(e.sidecars)+6)
+	for name, content := range e.sidecars {
+		files[name] = content
+	}
+
+	for name, file := range map[string]*hclwrite.File{
+		channelsFile:   e.channels,
+		monitorsFile:   e.monitors,
+		dashboardsFile: e.dashboards,
+	} {
+		if len(file.Body().Blocks()) > 0 {
+			files[name] = renderFile(file)
+		}
+	}
+
+	if len(e.imports) > 0 {
+		files[importsFile] = renderFile(e.importsFile())
+	}
+	if len(e.variables) > 0 {
+		files[variablesFile] = renderFile(e.variablesFile())
+		if e.opts.WriteSecrets {
+			files[secretsFile] = e.secretsFile()
+		}
+	}
+
+	return files
+}
+
+// importsFile builds an import block for every exported resource.
+func (e *exporter) importsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, target := range e.imports {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: target.resourceType},
+			hcl.TraverseAttr{Name: target.name},
+		})
+		block.SetAttributeValue("id", cty.StringVal(target.id))
+	}
+	return file
+}
+
+// variablesFile declares the sensitive variables that hold channel secrets and uptime check headers.
+func (e *exporter) variablesFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, v := range e.variables {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("variable", []string{v.name}).Body()
+		block.SetAttributeRaw("type", v.typ)
+		block.SetAttributeValue("sensitive", cty.True)
+	}
+	return file
+}
+
+// secretsFile assigns the secret values read from the API to their variables.
+// Variables without a value are listed as comments so they can be filled in.
+func (e *exporter) secretsFile() []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	var missing []string
+	for _, v := range e.variables {
+		if v.value.IsNull() {
+			missing = append(missing, v.name)
+			continue
+		}
+		body.SetAttributeValue(v.name, v.value)
+	}
+
+	out := []byte("# Secrets read from Uptrace. Do not commit this file.\n")
+	for _, name := range missing {
+		out = append(out, fmt.Sprintf("# %s = \"\" (not returned by the API)\n", name)...)
+	}
+	if len(missing) > 0 {
+		out = append(out, '\n')
+	}
+	return append(out, hclwrite.Format(file.Bytes())...)
+}
+
+// ignoreFile adds name to the .gitignore file in dir, creating it when missing.
+// It returns false when the file already lists name.
+func ignoreFile(dir, name string) (bool, error) {
+	target := filepath.Join(dir, gitignoreFile)
+	content, err := os.ReadFile(target)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("failed to read %s: %w", target, err)
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == name {
+			return false, nil
+		}
+	}
+
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		content = append(content, '\n')
+	}
+	content = append(content, name+"\n"...)
+	if err := os.WriteFile(target, content, 0o644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return true, nil
+}
+
+// renderFile formats a generated file and prepends the file header.
+func renderFile(file *hclwrite.File) []byte {
+	return append([]byte(fileHeader+"\n"), hclwrite.Format(file.Bytes())...)
+}
+
+// writeFiles writes files below dir. Nothing is written when any of the files already exists.
+func writeFiles(dir string, files map[string][]byte) error {
+	for name := range files {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err == nil {
+			return fmt.Errorf("refusing to overwrite existing file %s", filepath.Join(dir, name))
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to check %s: %w", filepath.Join(dir, name), err)
+		}
+	}
+
+	for name, content := range files {
+		target := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(target), err)
+		}
+
+		perm := fs.FileMode(0o644)
+		if name == secretsFile {
+			perm = 0o600
+		}
+		if err := os.WriteFile(target, content, perm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", target, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package export_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/export"
+)
+
+const testDashboardYAML = "schema: v2\nname: Service Overview\n"
+
+// newTestServer serves three channels, five monitors and a dashboard for project 1.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/projects/1/notification-channels": `{"channels": [
+			{"id": 20, "projectId": 1, "name": "Ops Webhook", "type": "webhook", "status": "delivered",
+			 "params": {"url": "https://hooks.example.com/x", "payload": {"text": "alert"}, "headers": {"X-Token": "abc"}}},
+			{"id": 10, "projectId": 1, "name": "Team Slack", "type": "slack", "status": "delivered",
+			 "priority": ["High"], "params": {"webhookUrl": "https://hooks.slack.com/services/T/B/X"}},
+			{"id": 30, "projectId": 1, "name": "On-Call", "type": "telegram", "status": "delivered",
+			 "params": {"botToken": "123456:ABC-DEF", "chatId": -1001234567890}}
+		]}`,
+		"/projects/1/monitors": `{"monitors": [
+			{"id": 1, "name": "High CPU", "type": "metric", "state": "active", "channelIds": [10, 99],
+			 "repeatInterval": {"strategy": "default"},
+			 "params": {"metrics": [{"name": "system_cpu_utilization", "alias": "$cpu"}], "query": "avg($cpu)",
+			  "column": "avg($cpu)", "maxAllowedValue": 90, "checkNumPoint": 1, "nullsMode": "allow",
+			  "groupingInterval": 60000, "timeOffset": 0}},
+			{"id": 2, "name": "Errors", "type": "error", "state": "paused",
+			 "params": {"metrics": [{"name": "uptrace_tracing_events"}], "query": "where service_name = 'api'"}},
+			{"id": 3, "name": "high-cpu", "type": "metric", "state": "active",
+			 "params": {"metrics": [], "query": "avg($cpu)", "column": "avg($cpu)", "checkNumPoint": 3}},
+			{"id": 4, "name": "Slow Spans", "type": "span", "state": "active",
+			 "params": {"query": "p99(_duration) | group by service_name", "column": "p99(_duration)",
+			  "maxAllowedValue": 2000, "groupingInterval": 60000, "checkNumPoint": 1}},
+			{"id": 5, "name": "Health Check", "type": "uptime", "state": "active",
+			 "params": {"url": "https://example.com/health", "method": "GET", "headers": {"Authorization": "Bearer xyz"},
+			  "expectedStatusCodes": [200], "checkInterval": 30, "timeout": 10, "followRedirects": true}}
+		]}`,
+		"/metrics/1/dashboards": `{"dashboards": [{"id": 5, "projectId": 1, "name": "Service Overview", "pinned": true}]}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/metrics/1/dashboards/5/yaml" {
+			w.Header().Set("Content-Type", "application/yaml")
+			_, _ = w.Write([]byte(testDashboardYAML))
+			return
+		}
+
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *client.Client {
+	t.Helper()
+
+	c, err := client.New(client.Config{
+		Endpoint:  server.URL,
+		Token:     "test-token",
+		ProjectID: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", name, err)
+	}
+	return string(content)
+}
+
+func assertContains(t *testing.T, name, content string, want ...string) {
+	t.Helper()
+
+	for _, w := range want {
+		if !strings.Contains(content, w) {
+			t.Errorf("Expected %s to contain %q, got:\n%s", name, w, content)
+		}
+	}
+}
+
+func assertNotContains(t *testing.T, name, content string, unwanted ...string) {
+	t.Helper()
+
+	for _, u := range unwanted {
+		if strings.Contains(content, u) {
+			t.Errorf("Expected %s not to contain %q, got:\n%s", name, u, content)
+		}
+	}
+}
+
+// TestRun tests exporting a project to Terraform configuration.
+func TestRun(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	result, err := export.Run(context.Background(), newTestClient(t, server), export.Options{Dir: dir})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if result.Monitors != 5 || result.Dashboards != 1 || result.NotificationChannels != 3 {
+		t.Errorf("Unexpected counts: %+v", result)
+	}
+
+	expectedFiles := []string{
+		"dashboards.tf",
+		"dashboards/service_overview.yaml",
+		"imports.tf",
+		"monitors.tf",
+		"notification_channels.tf",
+		"variables.tf",
+	}
+	if strings.Join(result.Files, ",") != strings.Join(expectedFiles, ",") {
+		t.Errorf("Expected files %v, got %v", expectedFiles, result.Files)
+	}
+	if result.Secrets != 5 || result.SecretsFile != "" {
+		t.Errorf("Expected 5 secrets without a secrets file, got %d and %q", result.Secrets, result.SecretsFile)
+	}
+
+	// Every generated file must be valid HCL.
+	parser := hclparse.NewParser()
+	for _, name := range expectedFiles {
+		if !strings.HasSuffix(name, ".tf") && !strings.HasSuffix(name, ".tfvars") {
+			continue
+		}
+		if _, diags := parser.ParseHCLFile(filepath.Join(dir, name)); diags.HasErrors() {
+			t.Errorf("Failed to parse %s: %s", name, diags.Error())
+		}
+	}
+
+	monitors := readFile(t, dir, "monitors.tf")
+	assertContains(t, "monitors.tf", monitors,
+		`resource "uptrace_monitor" "high_cpu" {`,
+		`resource "uptrace_monitor" "high_cpu_3" {`,
+		`resource "uptrace_monitor" "errors" {`,
+		"metric_params = {",
+		"error_params = {",
+		`channel_ids = [uptrace_notification_channel.team_slack.id, 99]`,
+		`max_allowed_value = 90`,
+		`check_num_point = 3`,
+		`alias = "$cpu"`,
+		`query = "where service_name = 'api'"`,
+		"paused = true",
+		"span_params = {",
+		`column            = "p99(_duration)"`,
+		`max_allowed_value = 2000`,
+		"uptime_params = {",
+		`url            = "https://example.com/health"`,
+		`headers        = var.health_check_headers`,
+		`check_interval = 30`,
+	)
+	// Server defaults are left out so the configuration stays minimal.
+	assertNotContains(t, "monitors.tf", monitors,
+		"repeat_interval", "nulls_mode", "grouping_interval", "time_offset", "check_num_point = 1", "project_id",
+		"method", "expected_status_codes", "timeout", "follow_redirects", "Bearer xyz")
+
+	channels := readFile(t, dir, "notification_channels.tf")
+	assertContains(t, "notification_channels.tf", channels,
+		`resource "uptrace_notification_channel" "team_slack" {`,
+		`webhook_url = var.team_slack_webhook_url`,
+		`priority = ["High"]`,
+		`url     = var.ops_webhook_url`,
+		`headers = var.ops_webhook_headers`,
+		`payload = "{\"text\":\"alert\"}"`,
+		`bot_token = var.on_call_bot_token`,
+		`chat_id   = "-1001234567890"`,
+	)
+	assertNotContains(t, "notification_channels.tf", channels, "hooks.slack.com", "abc", "ABC-DEF")
+
+	// Secrets are only declared, so no plaintext value is written
+	variables := readFile(t, dir, "variables.tf")
+	assertContains(t, "variables.tf", variables,
+		`variable "ops_webhook_headers" {`,
+		`type      = map(string)`,
+		`sensitive = true`,
+	)
+	assertNotContains(t, "variables.tf", variables, "hooks.slack.com", "abc", "Bearer xyz")
+
+	dashboards := readFile(t, dir, "dashboards.tf")
+	assertContains(t, "dashboards.tf", dashboards,
+		`yaml   = file("${path.module}/dashboards/service_overview.yaml")`,
+		`pinned = true`,
+	)
+	if yaml := readFile(t, dir, "dashboards/service_overview.yaml"); yaml != testDashboardYAML {
+		t.Errorf("Expected dashboard YAML %q, got %q", testDashboardYAML, yaml)
+	}
+
+	imports := readFile(t, dir, "imports.tf")
+	assertContains(t, "imports.tf", imports,
+		"to = uptrace_notification_channel.team_slack\n  id = \"10\"",
+		"to = uptrace_monitor.high_cpu_3\n  id = \"3\"",
+		"to = uptrace_dashboard.service_overview\n  id = \"5\"",
+	)
+}
+
+// TestRun_WriteSecrets tests that secrets are only written on request, to a git-ignored file.
+func TestRun_WriteSecrets(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".gitignore"), []byte(".terraform"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := export.Run(context.Background(), newTestClient(t, server), export.Options{Dir: dir, WriteSecrets: true})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if result.SecretsFile != "secrets.auto.tfvars" {
+		t.Errorf("Expected the secrets file to be reported, got %q", result.SecretsFile)
+	}
+
+	secrets := readFile(t, dir, "secrets.auto.tfvars")
+	assertContains(t, "secrets.auto.tfvars", secrets,
+		`team_slack_webhook_url = "https://hooks.slack.com/services/T/B/X"`,
+		`X-Token = "abc"`,
+	)
+	if info, err := os.Stat(filepath.Join(dir, "secrets.auto.tfvars")); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected the secrets file to be private, got %v, %v", info, err)
+	}
+
+	if gitignore := readFile(t, dir, ".gitignore"); gitignore != ".terraform\nsecrets.auto.tfvars\n" {
+		t.Errorf("Expected the secrets file to be appended to .gitignore, got %q", gitignore)
+	}
+}
+
+// TestRun_SetProjectID tests that project IDs are written to resources and import IDs.
+func TestRun_SetProjectID(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	_, err := export.Run(context.Background(), newTestClient(t, server), export.Options{Dir: dir, SetProjectID: true})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	assertContains(t, "monitors.tf", readFile(t, dir, "monitors.tf"), "project_id = 1")
+	assertContains(t, "imports.tf", readFile(t, dir, "imports.tf"), `id = "1/5"`, `id = "1/10"`)
+}
+
+// TestRun_RefusesToOverwrite tests that existing files are left untouched.
+func TestRun_RefusesToOverwrite(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "monitors.tf")
+	if err := os.WriteFile(existing, []byte("# mine\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := export.Run(context.Background(), newTestClient(t, server), export.Options{Dir: dir})
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Fatalf("Expected overwrite error, got %v", err)
+	}
+
+	if content := readFile(t, dir, "monitors.tf"); content != "# mine\n" {
+		t.Errorf("Existing file was modified: %q", content)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "imports.tf")); err == nil {
+		t.Error("Expected no files to be written")
+	}
+}
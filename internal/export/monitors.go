@@ -0,0 +1,256 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+const monitorResourceType = "uptrace_monitor"
+
+// exportMonitors writes a resource for every monitor and returns how many were exported.
+func (e *exporter) exportMonitors(ctx context.Context) (int, error) {
+	monitors, err := e.client.ListMonitors(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(monitors, func(i, j int) bool { return monitors[i].Id < monitors[j].Id })
+
+	for i := range monitors {
+		if err := e.writeMonitor(&monitors[i]); err != nil {
+			return 0, err
+		}
+	}
+	return len(monitors), nil
+}
+
+// writeMonitor writes the resource block of a monitor. Values holding server defaults are omitted.
+func (e *exporter) writeMonitor(monitor *generated.Monitor) error {
+	name := e.resourceName(monitorResourceType, monitor.Name, monitor.Id)
+	body := e.newResource(e.monitors, monitorResourceType, name, monitor.Id)
+	body.SetAttributeValue("name", cty.StringVal(monitor.Name))
+	body.SetAttributeValue("type", cty.StringVal(string(monitor.Type)))
+
+	if monitor.NotifyEveryoneByEmail != nil && *monitor.NotifyEveryoneByEmail {
+		body.SetAttributeValue("notify_everyone_by_email", cty.True)
+	}
+	if monitor.TeamIds != nil && len(*monitor.TeamIds) > 0 {
+		ids := make([]cty.Value, len(*monitor.TeamIds))
+		for i, id := range *monitor.TeamIds {
+			ids[i] = cty.NumberIntVal(id)
+		}
+		body.SetAttributeValue("team_ids", cty.ListVal(ids))
+	}
+	if monitor.ChannelIds != nil && len(*monitor.ChannelIds) > 0 {
+		body.SetAttributeRaw("channel_ids", e.channelReferences(*monitor.ChannelIds))
+	}
+	if attrs := repeatIntervalAttrs(monitor.RepeatInterval); attrs != nil {
+		body.SetAttributeValue("repeat_interval", cty.ObjectVal(attrs))
+	}
+	if monitor.TrendAggFunc != nil && *monitor.TrendAggFunc != "" {
+		body.SetAttributeValue("trend_agg_func", cty.StringVal(*monitor.TrendAggFunc))
+	}
+	if monitor.State == generated.MonitorStatePaused {
+		body.SetAttributeValue("paused", cty.True)
+	}
+
+	if monitor.Type == generated.MonitorTypeUptime {
+		p, err := monitor.Params.AsUptimeMonitorParams()
+		if err != nil {
+			return fmt.Errorf("failed to export monitor %d: %w", monitor.Id, err)
+		}
+		body.SetAttributeRaw("uptime_params", hclwrite.TokensForObject(e.uptimeParamsAttrs(name, &p)))
+		return nil
+	}
+
+	attribute, params, err := monitorParams(monitor)
+	if err != nil {
+		return fmt.Errorf("failed to export monitor %d: %w", monitor.Id, err)
+	}
+	body.SetAttributeValue(attribute, cty.ObjectVal(params))
+	return nil
+}
+
+// uptimeParamsAttrs returns the attributes of an uptime_params block. The headers are secrets,
+// as they usually carry credentials, and values holding server defaults are omitted.
+func (e *exporter) uptimeParamsAttrs(name string, p *generated.UptimeMonitorParams) []hclwrite.ObjectAttrTokens {
+	attrs := []hclwrite.ObjectAttrTokens{
+		objectAttr("url", hclwrite.TokensForValue(cty.StringVal(p.Url))),
+	}
+	if p.Method != nil && *p.Method != "" && *p.Method != client.DefaultUptimeMethod {
+		attrs = append(attrs, objectAttr("method", hclwrite.TokensForValue(cty.StringVal(string(*p.Method)))))
+	}
+	if p.Headers != nil && len(*p.Headers) > 0 {
+		values := make(map[string]cty.Value, len(*p.Headers))
+		for key, value := range *p.Headers {
+			values[key] = cty.StringVal(value)
+		}
+		attrs = append(attrs, objectAttr("headers", e.addSecret(name+"_headers", stringMapType, cty.MapVal(values))))
+	}
+	if p.Body != nil && *p.Body != "" {
+		attrs = append(attrs, objectAttr("body", hclwrite.TokensForValue(cty.StringVal(*p.Body))))
+	}
+	if p.ExpectedStatusCodes != nil && len(*p.ExpectedStatusCodes) > 0 &&
+		(len(*p.ExpectedStatusCodes) != 1 || (*p.ExpectedStatusCodes)[0] != client.DefaultUptimeStatusCode) {
+		codes := make([]cty.Value, len(*p.ExpectedStatusCodes))
+		for i, code := range *p.ExpectedStatusCodes {
+			codes[i] = cty.NumberIntVal(int64(code))
+		}
+		attrs = append(attrs, objectAttr("expected_status_codes", hclwrite.TokensForValue(cty.ListVal(codes))))
+	}
+	if p.CheckInterval != nil && *p.CheckInterval != client.DefaultUptimeCheckInterval {
+		attrs = append(attrs, objectAttr("check_interval", hclwrite.TokensForValue(cty.NumberIntVal(*p.CheckInterval))))
+	}
+	if p.Timeout != nil && *p.Timeout != client.DefaultUptimeTimeout {
+		attrs = append(attrs, objectAttr("timeout", hclwrite.TokensForValue(cty.NumberIntVal(*p.Timeout))))
+	}
+	if p.FollowRedirects != nil && *p.FollowRedirects != client.DefaultUptimeFollowRedirects {
+		attrs = append(attrs, objectAttr("follow_redirects", hclwrite.TokensForValue(cty.BoolVal(*p.FollowRedirects))))
+	}
+	return attrs
+}
+
+// channelReferences returns a list of channel IDs that references exported channels by address.
+// IDs of channels that were not exported are written as numbers.
+func (e *exporter) channelReferences(ids []int64) hclwrite.Tokens {
+	elems := make([]hclwrite.Tokens, len(ids))
+	for i, id := range ids {
+		name, ok := e.channelNames[id]
+		if !ok {
+			elems[i] = hclwrite.TokensForValue(cty.NumberIntVal(id))
+			continue
+		}
+		elems[i] = hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: channelResourceType},
+			hcl.TraverseAttr{Name: name},
+			hcl.TraverseAttr{Name: "id"},
+		})
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// repeatIntervalAttrs returns the repeat_interval attributes, or nil when the default strategy is used.
+func repeatIntervalAttrs(repeatInterval *generated.RepeatInterval) map[string]cty.Value {
+	if repeatInterval == nil || repeatInterval.Interval == nil &&
+		(repeatInterval.Strategy == nil || *repeatInterval.Strategy == generated.RepeatIntervalStrategyDefault) {
+		return nil
+	}
+
+	attrs := make(map[string]cty.Value, 2)
+	if repeatInterval.Strategy != nil {
+		attrs["strategy"] = cty.StringVal(string(*repeatInterval.Strategy))
+	}
+	if repeatInterval.Interval != nil {
+		attrs["interval"] = cty.NumberIntVal(*repeatInterval.Interval)
+	}
+	return attrs
+}
+
+// monitorParams returns the name and attributes of the typed params attribute of the monitor type.
+func monitorParams(monitor *generated.Monitor) (string, map[string]cty.Value, error) {
+	switch monitor.Type {
+	case generated.MonitorTypeMetric:
+		p, err := monitor.Params.AsMetricMonitorParams()
+		if err != nil {
+			return "", nil, err
+		}
+		params := map[string]cty.Value{
+			"metrics": metricsValue(p.Metrics),
+			"query":   cty.StringVal(p.Query),
+			"column":  cty.StringVal(p.Column),
+		}
+		if p.MinAllowedValue != nil {
+			params["min_allowed_value"] = cty.NumberFloatVal(*p.MinAllowedValue)
+		}
+		if p.MaxAllowedValue != nil {
+			params["max_allowed_value"] = cty.NumberFloatVal(*p.MaxAllowedValue)
+		}
+		if p.GroupingInterval != nil && *p.GroupingInterval != client.DefaultGroupingInterval {
+			params["grouping_interval"] = cty.NumberFloatVal(*p.GroupingInterval)
+		}
+		if p.CheckNumPoint != nil && *p.CheckNumPoint != client.DefaultCheckNumPoint {
+			params["check_num_point"] = cty.NumberIntVal(int64(*p.CheckNumPoint))
+		}
+		if p.NullsMode != nil && *p.NullsMode != client.DefaultNullsMode {
+			params["nulls_mode"] = cty.StringVal(string(*p.NullsMode))
+		}
+		if p.TimeOffset != nil && *p.TimeOffset != client.DefaultTimeOffset {
+			params["time_offset"] = cty.NumberFloatVal(*p.TimeOffset)
+		}
+		return "metric_params", params, nil
+	case generated.MonitorTypeError:
+		p, err := monitor.Params.AsErrorMonitorParams()
+		if err != nil {
+			return "", nil, err
+		}
+		params := map[string]cty.Value{
+			"metrics": metricsValue(p.Metrics),
+		}
+		if p.Query != nil && *p.Query != "" {
+			params["query"] = cty.StringVal(*p.Query)
+		}
+		return "error_params", params, nil
+	case generated.MonitorTypeSpan:
+		p, err := monitor.Params.AsSpanMonitorParams()
+		if err != nil {
+			return "", nil, err
+		}
+		return "span_params", eventParams(p), nil
+	case generated.MonitorTypeLog:
+		p, err := monitor.Params.AsLogMonitorParams()
+		if err != nil {
+			return "", nil, err
+		}
+		// Log monitors take the same params as span monitors.
+		return "log_params", eventParams(generated.SpanMonitorParams(p)), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported monitor type %q", monitor.Type)
+	}
+}
+
+// eventParams returns the attributes of span_params and log_params.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func eventParams(p generated.SpanMonitorParams) map[string]cty.Value {
+	params := map[string]cty.Value{
+		"query":  cty.StringVal(p.Query),
+		"column": cty.StringVal(p.Column),
+	}
+	if p.MinAllowedValue != nil {
+		params["min_allowed_value"] = cty.NumberFloatVal(*p.MinAllowedValue)
+	}
+	if p.MaxAllowedValue != nil {
+		params["max_allowed_value"] = cty.NumberFloatVal(*p.MaxAllowedValue)
+	}
+	if p.GroupingInterval != nil && *p.GroupingInterval != client.DefaultGroupingInterval {
+		params["grouping_interval"] = cty.NumberFloatVal(*p.GroupingInterval)
+	}
+	if p.CheckNumPoint != nil && *p.CheckNumPoint != client.DefaultCheckNumPoint {
+		params["check_num_point"] = cty.NumberIntVal(int64(*p.CheckNumPoint))
+	}
+	return params
+}
+
+// metricsValue converts metric definitions to a tuple of objects. Aliases are only set when present.
+func metricsValue(metrics []generated.MetricDefinition) cty.Value {
+	if len(metrics) == 0 {
+		return cty.EmptyTupleVal
+	}
+
+	values := make([]cty.Value, len(metrics))
+	for i, m := range metrics {
+		attrs := map[string]cty.Value{"name": cty.StringVal(m.Name)}
+		if m.Alias != nil && *m.Alias != "" {
+			attrs["alias"] = cty.StringVal(*m.Alias)
+		}
+		values[i] = cty.ObjectVal(attrs)
+	}
+	return cty.TupleVal(values)
+}
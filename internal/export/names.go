@@ -0,0 +1,36 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// nonIdentifierChars matches runs of characters not allowed in Terraform resource names.
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// slugify converts an object name to a Terraform identifier:
+// lowercase, with every run of other characters replaced by an underscore.
+func slugify(name string) string {
+	slug := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if slug == "" {
+		return "unnamed"
+	}
+	if slug[0] >= '0' && slug[0] <= '9' {
+		return "_" + slug
+	}
+	return slug
+}
+
+// nameSet tracks the resource names already used for one resource type.
+type nameSet map[string]bool
+
+// unique returns the slug of name, suffixed with the object ID when the slug is already taken.
+func (s nameSet) unique(name string, id int64) string {
+	slug := slugify(name)
+	if s[slug] {
+		slug = fmt.Sprintf("%s_%d", slug, id)
+	}
+	s[slug] = true
+	return slug
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &DashboardDataSource{}
+	_ datasource.DataSourceWithConfigure        = &DashboardDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &DashboardDataSource{}
+)
+
+// NewDashboardDataSource is a helper function to create the data source.
+func NewDashboardDataSource() datasource.DataSource {
+	return &DashboardDataSource{}
+}
+
+// DashboardDataSource is the data source implementation.
+type DashboardDataSource struct {
+	client *client.Client
+}
+
+// DashboardDataSourceModel describes the data source data model.
+type DashboardDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectID  types.Int64  `tfsdk:"project_id"`
+	Name       types.String `tfsdk:"name"`
+	YAML       types.String `tfsdk:"yaml"`
+	TemplateID types.String `tfsdk:"template_id"`
+	Pinned     types.Bool   `tfsdk:"pinned"`
+	GridQuery  types.String `tfsdk:"grid_query"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *DashboardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+// Schema defines the schema for the data source.
+func (d *DashboardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an existing Uptrace dashboard by ID or exact name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Dashboard identifier. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_id": projectIDDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Exact dashboard name. Exactly one of id or name must be set. " +
+					"The lookup fails if several dashboards share the name.",
+				Optional: true,
+				Computed: true,
+			},
+			"yaml": schema.StringAttribute{
+				Description: "Dashboard YAML definition as returned by Uptrace.",
+				Computed:    true,
+			},
+			"template_id": schema.StringAttribute{
+				Description: "Template ID if the dashboard was created from a template.",
+				Computed:    true,
+			},
+			"pinned": schema.BoolAttribute{
+				Description: "Whether the dashboard is pinned.",
+				Computed:    true,
+			},
+			"grid_query": schema.StringAttribute{
+				Description: "Global query filter applied to all grid items.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Dashboard creation timestamp.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Dashboard last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the data source-level validators.
+func (d *DashboardDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DashboardDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = uptraceClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DashboardDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading dashboard data source", map[string]any{
+		"id":   config.ID.ValueString(),
+		"name": config.Name.ValueString(),
+	})
+
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+
+	var dashboard *generated.Dashboard
+	if !config.ID.IsNull() {
+		dashboardID, ok := parseDashboardID(config.ID.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		var err error
+		dashboard, err = apiClient.GetDashboard(ctx, dashboardID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Dashboard",
+				fmt.Sprintf("Could not read dashboard ID %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	} else {
+		dashboard = findDashboardByName(ctx, apiClient, config.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Fetch the YAML definition
+	yamlContent, err := apiClient.GetDashboardYAML(ctx, dashboard.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Dashboard YAML",
+			fmt.Sprintf("Could not fetch YAML for dashboard ID %d: %s", dashboard.Id, err.Error()),
+		)
+		return
+	}
+
+	// Convert API response to state
+	model := convertDashboardToModel(ctx, dashboard, &resp.Diagnostics)
+	config.ID = model.ID
+	config.Name = model.Name
+	config.YAML = types.StringValue(yamlContent)
+	config.TemplateID = model.TemplateID
+	config.Pinned = model.Pinned
+	config.GridQuery = model.GridQuery
+	config.CreatedAt = model.CreatedAt
+	config.UpdatedAt = model.UpdatedAt
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Info(ctx, "Successfully read dashboard data source", map[string]any{"id": config.ID.ValueString()})
+}
+
+// findDashboardByName returns the single dashboard with the given exact name.
+func findDashboardByName(ctx context.Context, apiClient *client.Client, name string, diags *diag.Diagnostics) *generated.Dashboard {
+	dashboards, err := apiClient.ListDashboards(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Dashboards",
+			fmt.Sprintf("Could not list dashboards: %s", err.Error()),
+		)
+		return nil
+	}
+
+	var matches []generated.Dashboard
+	for i := range dashboards {
+		if dashboards[i].Name == name {
+			matches = append(matches, dashboards[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Dashboard Not Found",
+			fmt.Sprintf("No dashboard named %q exists in the project.", name),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	default:
+		ids := make([]int64, len(matches))
+		for i := range matches {
+			ids[i] = matches[i].Id
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Dashboard Name",
+			fmt.Sprintf("Found %d dashboards named %q (IDs %v). Look the dashboard up by id instead.", len(matches), name, ids),
+		)
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccDashboardDataSource_ByID(t *testing.T) {
+	resourceName := "uptrace_dashboard.test"
+	dataSourceName := "data.uptrace_dashboard.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-ds-dashboard-id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDataSourceConfig(dashboardName, "id = uptrace_dashboard.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pinned", resourceName, "pinned"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "yaml"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardDataSource_ByName(t *testing.T) {
+	resourceName := "uptrace_dashboard.test"
+	dataSourceName := "data.uptrace_dashboard.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-ds-dashboard-name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDataSourceConfig(dashboardName, "name = uptrace_dashboard.test.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", dashboardName),
+					resource.TestMatchResourceAttr(dataSourceName, "yaml", regexp.MustCompile(regexp.QuoteMeta(dashboardName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "uptrace_dashboard" "test" {
+  name = "tf-acc-nonexistent-dashboard-xyz-12345"
+}
+`, acceptancetests.GetTestProviderConfig()),
+				ExpectError: regexp.MustCompile(`Dashboard Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccDashboardDataSource_IDAndName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "uptrace_dashboard" "test" {
+  id   = "123"
+  name = "Overview"
+}
+`, acceptancetests.GetTestProviderConfig()),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// testAccDashboardDataSourceConfig generates a config that creates a dashboard and looks it up.
+func testAccDashboardDataSourceConfig(name, lookup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+    grid_rows:
+      - title: Metrics
+        items:
+          - title: CPU Usage
+            metrics:
+              - system.cpu.utilization as $cpu
+            query:
+              - avg($cpu)
+  YAML
+}
+
+data "uptrace_dashboard" "test" {
+  %s
+}
+`, acceptancetests.GetTestProviderConfig(), name, lookup)
+}
@@ -0,0 +1,785 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// GridItemChartModel represents the parameters of a chart grid item.
+type GridItemChartModel struct {
+	ChartKind     types.String `tfsdk:"chart_kind"`
+	Metrics       types.List   `tfsdk:"metrics"`
+	Query         types.String `tfsdk:"query"`
+	ConnectNulls  types.Bool   `tfsdk:"connect_nulls"`
+	Legend        types.Object `tfsdk:"legend"`
+	ColumnMap     types.Map    `tfsdk:"column_map"`
+	TimeseriesMap types.Map    `tfsdk:"timeseries_map"`
+}
+
+// GridItemTableModel represents the parameters of a table grid item.
+type GridItemTableModel struct {
+	Metrics      types.List   `tfsdk:"metrics"`
+	Query        types.String `tfsdk:"query"`
+	ColumnMap    types.Map    `tfsdk:"column_map"`
+	ItemsPerPage types.Int64  `tfsdk:"items_per_page"`
+	DenseTable   types.Bool   `tfsdk:"dense_table"`
+}
+
+// GridItemHeatmapModel represents the parameters of a heatmap grid item.
+type GridItemHeatmapModel struct {
+	Metric types.String `tfsdk:"metric"`
+	Query  types.String `tfsdk:"query"`
+	Unit   types.String `tfsdk:"unit"`
+}
+
+// GridItemGaugeModel represents the parameters of a gauge grid item.
+type GridItemGaugeModel struct {
+	Metrics       types.List   `tfsdk:"metrics"`
+	Query         types.String `tfsdk:"query"`
+	ColumnMap     types.Map    `tfsdk:"column_map"`
+	Template      types.String `tfsdk:"template"`
+	ValueMappings types.List   `tfsdk:"value_mappings"`
+}
+
+// GridItemMetricModel represents a metric with its alias.
+type GridItemMetricModel struct {
+	Name  types.String `tfsdk:"name"`
+	Alias types.String `tfsdk:"alias"`
+}
+
+// ChartLegendModel represents the legend configuration of a chart.
+type ChartLegendModel struct {
+	Type      types.String `tfsdk:"type"`
+	Placement types.String `tfsdk:"placement"`
+	Values    types.List   `tfsdk:"values"`
+	MaxLength types.Int64  `tfsdk:"max_length"`
+}
+
+// ChartColumnModel represents the configuration of a chart column.
+type ChartColumnModel struct {
+	Unit  types.String `tfsdk:"unit"`
+	Color types.String `tfsdk:"color"`
+}
+
+// TimeseriesStyleModel represents the styling of a chart timeseries.
+type TimeseriesStyleModel struct {
+	Color      types.String  `tfsdk:"color"`
+	Opacity    types.Int64   `tfsdk:"opacity"`
+	LineWidth  types.Float64 `tfsdk:"line_width"`
+	Symbol     types.String  `tfsdk:"symbol"`
+	SymbolSize types.Int64   `tfsdk:"symbol_size"`
+}
+
+// TableColumnModel represents the configuration of a table column.
+type TableColumnModel struct {
+	Unit              types.String `tfsdk:"unit"`
+	Color             types.String `tfsdk:"color"`
+	AggFunc           types.String `tfsdk:"agg_func"`
+	SparklineDisabled types.Bool   `tfsdk:"sparkline_disabled"`
+}
+
+// GaugeColumnModel represents the configuration of a gauge column.
+type GaugeColumnModel struct {
+	Unit    types.String `tfsdk:"unit"`
+	AggFunc types.String `tfsdk:"agg_func"`
+}
+
+// ValueMappingModel represents a gauge value-to-text/color mapping.
+type ValueMappingModel struct {
+	Op    types.String  `tfsdk:"op"`
+	Value types.Float64 `tfsdk:"value"`
+	Text  types.String  `tfsdk:"text"`
+	Color types.String  `tfsdk:"color"`
+}
+
+var gridItemMetricAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"alias": types.StringType,
+}
+
+var chartLegendAttrTypes = map[string]attr.Type{
+	"type":       types.StringType,
+	"placement":  types.StringType,
+	"values":     types.ListType{ElemType: types.StringType},
+	"max_length": types.Int64Type,
+}
+
+var chartColumnAttrTypes = map[string]attr.Type{
+	"unit":  types.StringType,
+	"color": types.StringType,
+}
+
+var timeseriesStyleAttrTypes = map[string]attr.Type{
+	"color":       types.StringType,
+	"opacity":     types.Int64Type,
+	"line_width":  types.Float64Type,
+	"symbol":      types.StringType,
+	"symbol_size": types.Int64Type,
+}
+
+var tableColumnAttrTypes = map[string]attr.Type{
+	"unit":               types.StringType,
+	"color":              types.StringType,
+	"agg_func":           types.StringType,
+	"sparkline_disabled": types.BoolType,
+}
+
+var gaugeColumnAttrTypes = map[string]attr.Type{
+	"unit":     types.StringType,
+	"agg_func": types.StringType,
+}
+
+var valueMappingAttrTypes = map[string]attr.Type{
+	"op":    types.StringType,
+	"value": types.Float64Type,
+	"text":  types.StringType,
+	"color": types.StringType,
+}
+
+var gridItemChartAttrTypes = map[string]attr.Type{
+	"chart_kind":     types.StringType,
+	"metrics":        types.ListType{ElemType: types.ObjectType{AttrTypes: gridItemMetricAttrTypes}},
+	"query":          types.StringType,
+	"connect_nulls":  types.BoolType,
+	"legend":         types.ObjectType{AttrTypes: chartLegendAttrTypes},
+	"column_map":     types.MapType{ElemType: types.ObjectType{AttrTypes: chartColumnAttrTypes}},
+	"timeseries_map": types.MapType{ElemType: types.ObjectType{AttrTypes: timeseriesStyleAttrTypes}},
+}
+
+var gridItemTableAttrTypes = map[string]attr.Type{
+	"metrics":        types.ListType{ElemType: types.ObjectType{AttrTypes: gridItemMetricAttrTypes}},
+	"query":          types.StringType,
+	"column_map":     types.MapType{ElemType: types.ObjectType{AttrTypes: tableColumnAttrTypes}},
+	"items_per_page": types.Int64Type,
+	"dense_table":    types.BoolType,
+}
+
+var gridItemHeatmapAttrTypes = map[string]attr.Type{
+	"metric": types.StringType,
+	"query":  types.StringType,
+	"unit":   types.StringType,
+}
+
+var gridItemGaugeAttrTypes = map[string]attr.Type{
+	"metrics":        types.ListType{ElemType: types.ObjectType{AttrTypes: gridItemMetricAttrTypes}},
+	"query":          types.StringType,
+	"column_map":     types.MapType{ElemType: types.ObjectType{AttrTypes: gaugeColumnAttrTypes}},
+	"template":       types.StringType,
+	"value_mappings": types.ListType{ElemType: types.ObjectType{AttrTypes: valueMappingAttrTypes}},
+}
+
+// planToGridItem converts a Terraform plan to an API GridItem.
+//
+//nolint:gocritic // Plan passed by value to keep function signatures consistent
+func planToGridItem(ctx context.Context, plan DashboardGridItemResourceModel, diags *diag.Diagnostics) generated.GridItem {
+	item := generated.GridItem{
+		Title:    plan.Title.ValueString(),
+		DashKind: generated.GridItemDashKindTable,
+	}
+
+	if !plan.ID.IsNull() && !plan.ID.IsUnknown() {
+		if id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64); err == nil {
+			item.Id = id
+		}
+	}
+
+	if id, err := strconv.ParseInt(plan.DashboardID.ValueString(), 10, 64); err == nil {
+		item.DashId = id
+	}
+
+	// Items attached to a row live in the grid section, others in the table section
+	if !plan.RowID.IsNull() && !plan.RowID.IsUnknown() {
+		rowID, err := strconv.ParseInt(plan.RowID.ValueString(), 10, 64)
+		if err != nil {
+			diags.AddError(
+				"Invalid Grid Row ID",
+				fmt.Sprintf("Could not parse grid row ID %s: %s", plan.RowID.ValueString(), err.Error()),
+			)
+			return item
+		}
+		item.RowId = &rowID
+		item.DashKind = generated.GridItemDashKindGrid
+	}
+
+	item.Description = optionalString(plan.Description)
+	item.Width = optionalInt(plan.Width)
+	item.Height = optionalInt(plan.Height)
+	item.XAxis = optionalInt(plan.X)
+	item.YAxis = optionalInt(plan.Y)
+
+	var params generated.GridItem_Params
+	var err error
+	switch {
+	case isKnownObject(plan.Chart):
+		item.Type = generated.GridItemTypeChart
+		err = params.FromChartGridItemParams(convertToChartParams(ctx, plan.Chart, diags))
+	case isKnownObject(plan.Table):
+		item.Type = generated.GridItemTypeTable
+		err = params.FromTableGridItemParams(convertToTableParams(ctx, plan.Table, diags))
+	case isKnownObject(plan.Heatmap):
+		item.Type = generated.GridItemTypeHeatmap
+		err = params.FromHeatmapGridItemParams(convertToHeatmapParams(ctx, plan.Heatmap, diags))
+	case isKnownObject(plan.Gauge):
+		item.Type = generated.GridItemTypeGauge
+		err = params.FromGaugeGridItemParams(convertToGaugeParams(ctx, plan.Gauge, diags))
+	default:
+		diags.AddError(
+			"Missing Grid Item Parameters",
+			"Exactly one of chart, table, heatmap or gauge must be configured.",
+		)
+		return item
+	}
+	if err != nil {
+		diags.AddError("Failed to convert grid item params", err.Error())
+		return item
+	}
+	item.Params = &params
+
+	return item
+}
+
+// convertGridItemMetricsToAPI converts Terraform metric aliases to API format.
+func convertGridItemMetricsToAPI(ctx context.Context, list types.List, diags *diag.Diagnostics) []generated.MetricAlias {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var metrics []GridItemMetricModel
+	diags.Append(list.ElementsAs(ctx, &metrics, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	apiMetrics := make([]generated.MetricAlias, len(metrics))
+	for i, m := range metrics {
+		apiMetrics[i] = generated.MetricAlias{
+			Name:  m.Name.ValueString(),
+			Alias: m.Alias.ValueString(),
+		}
+	}
+	return apiMetrics
+}
+
+// convertToChartParams converts a chart block to ChartGridItemParams.
+func convertToChartParams(ctx context.Context, obj types.Object, diags *diag.Diagnostics) generated.ChartGridItemParams {
+	var chart GridItemChartModel
+	diags.Append(obj.As(ctx, &chart, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return generated.ChartGridItemParams{}
+	}
+
+	params := generated.ChartGridItemParams{
+		Metrics:      convertGridItemMetricsToAPI(ctx, chart.Metrics, diags),
+		Query:        chart.Query.ValueString(),
+		ConnectNulls: optionalBool(chart.ConnectNulls),
+	}
+
+	if !chart.ChartKind.IsNull() && !chart.ChartKind.IsUnknown() {
+		kind := generated.ChartGridItemParamsChartKind(chart.ChartKind.ValueString())
+		params.ChartKind = &kind
+	}
+
+	if isKnownObject(chart.Legend) {
+		var legend ChartLegendModel
+		diags.Append(chart.Legend.As(ctx, &legend, basetypes.ObjectAsOptions{})...)
+		params.Legend = convertLegendToAPI(ctx, legend, diags)
+	}
+
+	if !chart.ColumnMap.IsNull() && !chart.ColumnMap.IsUnknown() {
+		var columns map[string]ChartColumnModel
+		diags.Append(chart.ColumnMap.ElementsAs(ctx, &columns, false)...)
+		columnMap := make(map[string]generated.MetricColumn, len(columns))
+		for name, col := range columns {
+			columnMap[name] = generated.MetricColumn{
+				Unit:  optionalString(col.Unit),
+				Color: optionalString(col.Color),
+			}
+		}
+		params.ColumnMap = &columnMap
+	}
+
+	if !chart.TimeseriesMap.IsNull() && !chart.TimeseriesMap.IsUnknown() {
+		var styles map[string]TimeseriesStyleModel
+		diags.Append(chart.TimeseriesMap.ElementsAs(ctx, &styles, false)...)
+		timeseriesMap := make(map[string]generated.TimeseriesStyle, len(styles))
+		for name, style := range styles {
+			apiStyle := generated.TimeseriesStyle{
+				Color:      optionalString(style.Color),
+				Opacity:    optionalInt(style.Opacity),
+				SymbolSize: optionalInt(style.SymbolSize),
+			}
+			if !style.LineWidth.IsNull() && !style.LineWidth.IsUnknown() {
+				width := float32(style.LineWidth.ValueFloat64())
+				apiStyle.LineWidth = &width
+			}
+			if !style.Symbol.IsNull() && !style.Symbol.IsUnknown() {
+				symbol := generated.TimeseriesStyleSymbol(style.Symbol.ValueString())
+				apiStyle.Symbol = &symbol
+			}
+			timeseriesMap[name] = apiStyle
+		}
+		params.TimeseriesMap = &timeseriesMap
+	}
+
+	return params
+}
+
+// convertLegendToAPI converts a legend model to ChartLegend.
+//
+//nolint:gocritic // Model passed by value to avoid pointer complexity in conversion
+func convertLegendToAPI(ctx context.Context, legend ChartLegendModel, diags *diag.Diagnostics) *generated.ChartLegend {
+	apiLegend := generated.ChartLegend{
+		MaxLength: optionalInt(legend.MaxLength),
+	}
+
+	if !legend.Type.IsNull() && !legend.Type.IsUnknown() {
+		legendType := generated.ChartLegendType(legend.Type.ValueString())
+		apiLegend.Type = &legendType
+	}
+
+	if !legend.Placement.IsNull() && !legend.Placement.IsUnknown() {
+		placement := generated.ChartLegendPlacement(legend.Placement.ValueString())
+		apiLegend.Placement = &placement
+	}
+
+	if !legend.Values.IsNull() && !legend.Values.IsUnknown() {
+		var values []string
+		diags.Append(legend.Values.ElementsAs(ctx, &values, false)...)
+		apiValues := make([]generated.ChartLegendValues, len(values))
+		for i, v := range values {
+			apiValues[i] = generated.ChartLegendValues(v)
+		}
+		apiLegend.Values = &apiValues
+	}
+
+	return &apiLegend
+}
+
+// convertToTableParams converts a table block to TableGridItemParams.
+func convertToTableParams(ctx context.Context, obj types.Object, diags *diag.Diagnostics) generated.TableGridItemParams {
+	var table GridItemTableModel
+	diags.Append(obj.As(ctx, &table, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return generated.TableGridItemParams{}
+	}
+
+	params := generated.TableGridItemParams{
+		Metrics:      convertGridItemMetricsToAPI(ctx, table.Metrics, diags),
+		Query:        table.Query.ValueString(),
+		ItemsPerPage: optionalInt(table.ItemsPerPage),
+		DenseTable:   optionalBool(table.DenseTable),
+	}
+
+	if !table.ColumnMap.IsNull() && !table.ColumnMap.IsUnknown() {
+		var columns map[string]TableColumnModel
+		diags.Append(table.ColumnMap.ElementsAs(ctx, &columns, false)...)
+		columnMap := make(map[string]generated.TableColumn, len(columns))
+		for name, col := range columns {
+			apiCol := generated.TableColumn{
+				Unit:              optionalString(col.Unit),
+				Color:             optionalString(col.Color),
+				SparklineDisabled: optionalBool(col.SparklineDisabled),
+			}
+			if !col.AggFunc.IsNull() && !col.AggFunc.IsUnknown() {
+				aggFunc := generated.TableColumnAggFunc(col.AggFunc.ValueString())
+				apiCol.AggFunc = &aggFunc
+			}
+			columnMap[name] = apiCol
+		}
+		params.ColumnMap = &columnMap
+	}
+
+	return params
+}
+
+// convertToHeatmapParams converts a heatmap block to HeatmapGridItemParams.
+func convertToHeatmapParams(ctx context.Context, obj types.Object, diags *diag.Diagnostics) generated.HeatmapGridItemParams {
+	var heatmap GridItemHeatmapModel
+	diags.Append(obj.As(ctx, &heatmap, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return generated.HeatmapGridItemParams{}
+	}
+
+	return generated.HeatmapGridItemParams{
+		Metric: heatmap.Metric.ValueString(),
+		Query:  heatmap.Query.ValueString(),
+		Unit:   optionalString(heatmap.Unit),
+	}
+}
+
+// convertToGaugeParams converts a gauge block to GaugeGridItemParams.
+func convertToGaugeParams(ctx context.Context, obj types.Object, diags *diag.Diagnostics) generated.GaugeGridItemParams {
+	var gauge GridItemGaugeModel
+	diags.Append(obj.As(ctx, &gauge, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return generated.GaugeGridItemParams{}
+	}
+
+	params := generated.GaugeGridItemParams{
+		Metrics:  convertGridItemMetricsToAPI(ctx, gauge.Metrics, diags),
+		Query:    gauge.Query.ValueString(),
+		Template: optionalString(gauge.Template),
+	}
+
+	if !gauge.ColumnMap.IsNull() && !gauge.ColumnMap.IsUnknown() {
+		var columns map[string]GaugeColumnModel
+		diags.Append(gauge.ColumnMap.ElementsAs(ctx, &columns, false)...)
+		columnMap := make(map[string]generated.GaugeColumn, len(columns))
+		for name, col := range columns {
+			apiCol := generated.GaugeColumn{
+				Unit: optionalString(col.Unit),
+			}
+			if !col.AggFunc.IsNull() && !col.AggFunc.IsUnknown() {
+				aggFunc := generated.GaugeColumnAggFunc(col.AggFunc.ValueString())
+				apiCol.AggFunc = &aggFunc
+			}
+			columnMap[name] = apiCol
+		}
+		params.ColumnMap = &columnMap
+	}
+
+	if !gauge.ValueMappings.IsNull() && !gauge.ValueMappings.IsUnknown() {
+		var mappings []ValueMappingModel
+		diags.Append(gauge.ValueMappings.ElementsAs(ctx, &mappings, false)...)
+		apiMappings := make([]generated.ValueMapping, len(mappings))
+		for i, m := range mappings {
+			apiMappings[i] = generated.ValueMapping{
+				Text:  optionalString(m.Text),
+				Color: optionalString(m.Color),
+			}
+			if !m.Op.IsNull() && !m.Op.IsUnknown() {
+				op := generated.ValueMappingOp(m.Op.ValueString())
+				apiMappings[i].Op = &op
+			}
+			if !m.Value.IsNull() && !m.Value.IsUnknown() {
+				value := float32(m.Value.ValueFloat64())
+				apiMappings[i].Value = &value
+			}
+		}
+		params.ValueMappings = &apiMappings
+	}
+
+	return params
+}
+
+// gridItemToState converts an API GridItem to Terraform state.
+func gridItemToState(ctx context.Context, item *generated.GridItem, state *DashboardGridItemResourceModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(fmt.Sprintf("%d", item.Id))
+	state.DashboardID = types.StringValue(fmt.Sprintf("%d", item.DashId))
+	state.Title = types.StringValue(item.Title)
+	state.Type = types.StringValue(string(item.Type))
+	state.Description = stringValueOrNull(item.Description)
+
+	if item.RowId != nil && *item.RowId != 0 {
+		state.RowID = types.StringValue(fmt.Sprintf("%d", *item.RowId))
+	} else {
+		state.RowID = types.StringNull()
+	}
+
+	state.Width = int64ValueOrNull(item.Width)
+	state.Height = int64ValueOrNull(item.Height)
+	state.X = int64ValueOrNull(item.XAxis)
+	state.Y = int64ValueOrNull(item.YAxis)
+
+	state.Chart = types.ObjectNull(gridItemChartAttrTypes)
+	state.Table = types.ObjectNull(gridItemTableAttrTypes)
+	state.Heatmap = types.ObjectNull(gridItemHeatmapAttrTypes)
+	state.Gauge = types.ObjectNull(gridItemGaugeAttrTypes)
+
+	if item.Params == nil {
+		return
+	}
+
+	var err error
+	switch item.Type {
+	case generated.GridItemTypeChart:
+		var params generated.ChartGridItemParams
+		if params, err = item.Params.AsChartGridItemParams(); err == nil {
+			state.Chart = convertChartParamsToObject(ctx, params, diags)
+		}
+	case generated.GridItemTypeTable:
+		var params generated.TableGridItemParams
+		if params, err = item.Params.AsTableGridItemParams(); err == nil {
+			state.Table = convertTableParamsToObject(ctx, params, diags)
+		}
+	case generated.GridItemTypeHeatmap:
+		var params generated.HeatmapGridItemParams
+		if params, err = item.Params.AsHeatmapGridItemParams(); err == nil {
+			state.Heatmap = types.ObjectValueMust(gridItemHeatmapAttrTypes, map[string]attr.Value{
+				"metric": types.StringValue(params.Metric),
+				"query":  types.StringValue(params.Query),
+				"unit":   stringValueOrNull(params.Unit),
+			})
+		}
+	case generated.GridItemTypeGauge:
+		var params generated.GaugeGridItemParams
+		if params, err = item.Params.AsGaugeGridItemParams(); err == nil {
+			state.Gauge = convertGaugeParamsToObject(ctx, params, diags)
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Failed to parse grid item params", err.Error())
+	}
+}
+
+// convertGridItemMetricsToListValue converts API metric aliases to a Terraform list value.
+func convertGridItemMetricsToListValue(metrics []generated.MetricAlias) types.List {
+	elemType := types.ObjectType{AttrTypes: gridItemMetricAttrTypes}
+	values := make([]attr.Value, len(metrics))
+	for i, m := range metrics {
+		values[i] = types.ObjectValueMust(gridItemMetricAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(m.Name),
+			"alias": types.StringValue(m.Alias),
+		})
+	}
+	return types.ListValueMust(elemType, values)
+}
+
+// convertChartParamsToObject converts chart params to a Terraform object value.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func convertChartParamsToObject(ctx context.Context, params generated.ChartGridItemParams, diags *diag.Diagnostics) types.Object {
+	chart := GridItemChartModel{
+		ChartKind:     types.StringNull(),
+		Metrics:       convertGridItemMetricsToListValue(params.Metrics),
+		Query:         types.StringValue(params.Query),
+		ConnectNulls:  boolValueOrNull(params.ConnectNulls),
+		Legend:        types.ObjectNull(chartLegendAttrTypes),
+		ColumnMap:     types.MapNull(types.ObjectType{AttrTypes: chartColumnAttrTypes}),
+		TimeseriesMap: types.MapNull(types.ObjectType{AttrTypes: timeseriesStyleAttrTypes}),
+	}
+
+	if params.ChartKind != nil {
+		chart.ChartKind = types.StringValue(string(*params.ChartKind))
+	}
+
+	if params.Legend != nil {
+		legend := ChartLegendModel{
+			Type:      types.StringNull(),
+			Placement: types.StringNull(),
+			Values:    types.ListNull(types.StringType),
+			MaxLength: int64ValueOrNull(params.Legend.MaxLength),
+		}
+		if params.Legend.Type != nil {
+			legend.Type = types.StringValue(string(*params.Legend.Type))
+		}
+		if params.Legend.Placement != nil {
+			legend.Placement = types.StringValue(string(*params.Legend.Placement))
+		}
+		if params.Legend.Values != nil {
+			values := make([]attr.Value, len(*params.Legend.Values))
+			for i, v := range *params.Legend.Values {
+				values[i] = types.StringValue(string(v))
+			}
+			legend.Values = types.ListValueMust(types.StringType, values)
+		}
+		var d diag.Diagnostics
+		chart.Legend, d = types.ObjectValueFrom(ctx, chartLegendAttrTypes, legend)
+		diags.Append(d...)
+	}
+
+	if params.ColumnMap != nil && len(*params.ColumnMap) > 0 {
+		columns := make(map[string]ChartColumnModel, len(*params.ColumnMap))
+		for name, col := range *params.ColumnMap {
+			columns[name] = ChartColumnModel{
+				Unit:  stringValueOrNull(col.Unit),
+				Color: stringValueOrNull(col.Color),
+			}
+		}
+		var d diag.Diagnostics
+		chart.ColumnMap, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: chartColumnAttrTypes}, columns)
+		diags.Append(d...)
+	}
+
+	if params.TimeseriesMap != nil && len(*params.TimeseriesMap) > 0 {
+		styles := make(map[string]TimeseriesStyleModel, len(*params.TimeseriesMap))
+		for name, style := range *params.TimeseriesMap {
+			model := TimeseriesStyleModel{
+				Color:      stringValueOrNull(style.Color),
+				Opacity:    int64ValueOrNull(style.Opacity),
+				LineWidth:  types.Float64Null(),
+				Symbol:     types.StringNull(),
+				SymbolSize: int64ValueOrNull(style.SymbolSize),
+			}
+			if style.LineWidth != nil {
+				model.LineWidth = types.Float64Value(float32ToFloat64(*style.LineWidth))
+			}
+			if style.Symbol != nil {
+				model.Symbol = types.StringValue(string(*style.Symbol))
+			}
+			styles[name] = model
+		}
+		var d diag.Diagnostics
+		chart.TimeseriesMap, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: timeseriesStyleAttrTypes}, styles)
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, gridItemChartAttrTypes, chart)
+	diags.Append(d...)
+	return obj
+}
+
+// convertTableParamsToObject converts table params to a Terraform object value.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func convertTableParamsToObject(ctx context.Context, params generated.TableGridItemParams, diags *diag.Diagnostics) types.Object {
+	table := GridItemTableModel{
+		Metrics:      convertGridItemMetricsToListValue(params.Metrics),
+		Query:        types.StringValue(params.Query),
+		ColumnMap:    types.MapNull(types.ObjectType{AttrTypes: tableColumnAttrTypes}),
+		ItemsPerPage: int64ValueOrNull(params.ItemsPerPage),
+		DenseTable:   boolValueOrNull(params.DenseTable),
+	}
+
+	if params.ColumnMap != nil && len(*params.ColumnMap) > 0 {
+		columns := make(map[string]TableColumnModel, len(*params.ColumnMap))
+		for name, col := range *params.ColumnMap {
+			model := TableColumnModel{
+				Unit:              stringValueOrNull(col.Unit),
+				Color:             stringValueOrNull(col.Color),
+				AggFunc:           types.StringNull(),
+				SparklineDisabled: boolValueOrNull(col.SparklineDisabled),
+			}
+			if col.AggFunc != nil {
+				model.AggFunc = types.StringValue(string(*col.AggFunc))
+			}
+			columns[name] = model
+		}
+		var d diag.Diagnostics
+		table.ColumnMap, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: tableColumnAttrTypes}, columns)
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, gridItemTableAttrTypes, table)
+	diags.Append(d...)
+	return obj
+}
+
+// convertGaugeParamsToObject converts gauge params to a Terraform object value.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func convertGaugeParamsToObject(ctx context.Context, params generated.GaugeGridItemParams, diags *diag.Diagnostics) types.Object {
+	gauge := GridItemGaugeModel{
+		Metrics:       convertGridItemMetricsToListValue(params.Metrics),
+		Query:         types.StringValue(params.Query),
+		ColumnMap:     types.MapNull(types.ObjectType{AttrTypes: gaugeColumnAttrTypes}),
+		Template:      stringValueOrNull(params.Template),
+		ValueMappings: types.ListNull(types.ObjectType{AttrTypes: valueMappingAttrTypes}),
+	}
+
+	if params.ColumnMap != nil && len(*params.ColumnMap) > 0 {
+		columns := make(map[string]GaugeColumnModel, len(*params.ColumnMap))
+		for name, col := range *params.ColumnMap {
+			model := GaugeColumnModel{
+				Unit:    stringValueOrNull(col.Unit),
+				AggFunc: types.StringNull(),
+			}
+			if col.AggFunc != nil {
+				model.AggFunc = types.StringValue(string(*col.AggFunc))
+			}
+			columns[name] = model
+		}
+		var d diag.Diagnostics
+		gauge.ColumnMap, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: gaugeColumnAttrTypes}, columns)
+		diags.Append(d...)
+	}
+
+	if params.ValueMappings != nil && len(*params.ValueMappings) > 0 {
+		mappings := make([]ValueMappingModel, len(*params.ValueMappings))
+		for i, m := range *params.ValueMappings {
+			mappings[i] = ValueMappingModel{
+				Op:    types.StringNull(),
+				Value: types.Float64Null(),
+				Text:  stringValueOrNull(m.Text),
+				Color: stringValueOrNull(m.Color),
+			}
+			if m.Op != nil {
+				mappings[i].Op = types.StringValue(string(*m.Op))
+			}
+			if m.Value != nil {
+				mappings[i].Value = types.Float64Value(float32ToFloat64(*m.Value))
+			}
+		}
+		var d diag.Diagnostics
+		gauge.ValueMappings, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: valueMappingAttrTypes}, mappings)
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, gridItemGaugeAttrTypes, gauge)
+	diags.Append(d...)
+	return obj
+}
+
+// isKnownObject reports whether an object value is set and known.
+func isKnownObject(obj types.Object) bool {
+	return !obj.IsNull() && !obj.IsUnknown()
+}
+
+// optionalString returns a pointer to the value, or nil when null or unknown.
+func optionalString(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// optionalInt returns a pointer to the value as int, or nil when null or unknown.
+func optionalInt(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// optionalBool returns a pointer to the value, or nil when null or unknown.
+func optionalBool(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	b := v.ValueBool()
+	return &b
+}
+
+// stringValueOrNull converts an optional API string, treating empty strings as null.
+func stringValueOrNull(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+// int64ValueOrNull converts an optional API integer.
+func int64ValueOrNull(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
+
+// boolValueOrNull converts an optional API boolean.
+func boolValueOrNull(b *bool) types.Bool {
+	if b == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*b)
+}
+
+// float32ToFloat64 widens an API float32 using its shortest decimal representation,
+// so values such as 0.1 do not pick up float32 rounding noise.
+func float32ToFloat64(f float32) float64 {
+	v, err := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+	if err != nil {
+		return float64(f)
+	}
+	return v
+}
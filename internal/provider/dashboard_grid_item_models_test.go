@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+func testGridItemMetrics(t *testing.T) types.List {
+	t.Helper()
+	return types.ListValueMust(types.ObjectType{AttrTypes: gridItemMetricAttrTypes}, []attr.Value{
+		types.ObjectValueMust(gridItemMetricAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue("uptrace_tracing_spans"),
+			"alias": types.StringValue("spans"),
+		}),
+	})
+}
+
+func testGridItemPlan() DashboardGridItemResourceModel {
+	return DashboardGridItemResourceModel{
+		ID:          types.StringUnknown(),
+		DashboardID: types.StringValue("123"),
+		RowID:       types.StringNull(),
+		Title:       types.StringValue("Requests"),
+		Description: types.StringNull(),
+		X:           types.Int64Unknown(),
+		Y:           types.Int64Unknown(),
+		Width:       types.Int64Value(12),
+		Height:      types.Int64Unknown(),
+		Chart:       types.ObjectNull(gridItemChartAttrTypes),
+		Table:       types.ObjectNull(gridItemTableAttrTypes),
+		Heatmap:     types.ObjectNull(gridItemHeatmapAttrTypes),
+		Gauge:       types.ObjectNull(gridItemGaugeAttrTypes),
+	}
+}
+
+func TestPlanToGridItem_Chart(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	plan := testGridItemPlan()
+	plan.RowID = types.StringValue("7")
+	plan.Chart = types.ObjectValueMust(gridItemChartAttrTypes, map[string]attr.Value{
+		"chart_kind":    types.StringValue("stacked-bar"),
+		"metrics":       testGridItemMetrics(t),
+		"query":         types.StringValue("per_min(sum($spans))"),
+		"connect_nulls": types.BoolUnknown(),
+		"legend": types.ObjectValueMust(chartLegendAttrTypes, map[string]attr.Value{
+			"type":       types.StringValue("table"),
+			"placement":  types.StringUnknown(),
+			"values":     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("max")}),
+			"max_length": types.Int64Null(),
+		}),
+		"column_map":     types.MapNull(types.ObjectType{AttrTypes: chartColumnAttrTypes}),
+		"timeseries_map": types.MapNull(types.ObjectType{AttrTypes: timeseriesStyleAttrTypes}),
+	})
+
+	item := planToGridItem(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, generated.GridItemTypeChart, item.Type)
+	assert.Equal(t, generated.GridItemDashKindGrid, item.DashKind, "Items with a row should be grid items")
+	require.NotNil(t, item.RowId)
+	assert.Equal(t, int64(7), *item.RowId)
+	assert.Equal(t, int64(123), item.DashId)
+	require.NotNil(t, item.Width)
+	assert.Equal(t, 12, *item.Width)
+	assert.Nil(t, item.Height, "Unknown height should not be sent")
+
+	require.NotNil(t, item.Params)
+	params, err := item.Params.AsChartGridItemParams()
+	require.NoError(t, err)
+	require.NotNil(t, params.ChartKind)
+	assert.Equal(t, generated.ChartGridItemParamsChartKind("stacked-bar"), *params.ChartKind)
+	require.Len(t, params.Metrics, 1)
+	assert.Equal(t, "spans", params.Metrics[0].Alias)
+	assert.Nil(t, params.ConnectNulls)
+	require.NotNil(t, params.Legend)
+	require.NotNil(t, params.Legend.Type)
+	assert.Equal(t, generated.ChartLegendType("table"), *params.Legend.Type)
+	assert.Nil(t, params.Legend.Placement)
+	require.NotNil(t, params.Legend.Values)
+	assert.Equal(t, []generated.ChartLegendValues{"max"}, *params.Legend.Values)
+}
+
+func TestPlanToGridItem_TableSection(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	plan := testGridItemPlan()
+	plan.Gauge = types.ObjectValueMust(gridItemGaugeAttrTypes, map[string]attr.Value{
+		"metrics": testGridItemMetrics(t),
+		"query":   types.StringValue("count($spans)"),
+		"column_map": types.MapValueMust(types.ObjectType{AttrTypes: gaugeColumnAttrTypes}, map[string]attr.Value{
+			"count($spans)": types.ObjectValueMust(gaugeColumnAttrTypes, map[string]attr.Value{
+				"unit":     types.StringValue("percent"),
+				"agg_func": types.StringValue("last"),
+			}),
+		}),
+		"template": types.StringNull(),
+		"value_mappings": types.ListValueMust(types.ObjectType{AttrTypes: valueMappingAttrTypes}, []attr.Value{
+			types.ObjectValueMust(valueMappingAttrTypes, map[string]attr.Value{
+				"op":    types.StringValue("gt"),
+				"value": types.Float64Value(0.9),
+				"text":  types.StringNull(),
+				"color": types.StringValue("#ff0000"),
+			}),
+		}),
+	})
+
+	item := planToGridItem(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, generated.GridItemTypeGauge, item.Type)
+	assert.Equal(t, generated.GridItemDashKindTable, item.DashKind, "Items without a row should be table items")
+	assert.Nil(t, item.RowId)
+
+	params, err := item.Params.AsGaugeGridItemParams()
+	require.NoError(t, err)
+	require.NotNil(t, params.ColumnMap)
+	column := (*params.ColumnMap)["count($spans)"]
+	require.NotNil(t, column.AggFunc)
+	assert.Equal(t, generated.GaugeColumnAggFunc("last"), *column.AggFunc)
+	require.NotNil(t, params.ValueMappings)
+	require.Len(t, *params.ValueMappings, 1)
+	assert.Equal(t, generated.ValueMappingOp("gt"), *(*params.ValueMappings)[0].Op)
+}
+
+func TestPlanToGridItem_MissingParams(t *testing.T) {
+	diags := diag.Diagnostics{}
+
+	planToGridItem(context.Background(), testGridItemPlan(), &diags)
+
+	assert.True(t, diags.HasError(), "A plan without a type block should produce an error")
+}
+
+func TestGridItemToState_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	plan := testGridItemPlan()
+	plan.Table = types.ObjectValueMust(gridItemTableAttrTypes, map[string]attr.Value{
+		"metrics": testGridItemMetrics(t),
+		"query":   types.StringValue("group by service_name | count($spans)"),
+		"column_map": types.MapValueMust(types.ObjectType{AttrTypes: tableColumnAttrTypes}, map[string]attr.Value{
+			"count($spans)": types.ObjectValueMust(tableColumnAttrTypes, map[string]attr.Value{
+				"unit":               types.StringValue("short"),
+				"color":              types.StringNull(),
+				"agg_func":           types.StringValue("sum"),
+				"sparkline_disabled": types.BoolValue(true),
+			}),
+		}),
+		"items_per_page": types.Int64Value(25),
+		"dense_table":    types.BoolValue(true),
+	})
+
+	item := planToGridItem(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	item.Id = 42
+
+	var state DashboardGridItemResourceModel
+	gridItemToState(ctx, &item, &state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "42", state.ID.ValueString())
+	assert.Equal(t, "table", state.Type.ValueString())
+	assert.True(t, state.RowID.IsNull())
+	assert.Equal(t, int64(12), state.Width.ValueInt64())
+	assert.True(t, state.Chart.IsNull())
+	assert.True(t, state.Heatmap.IsNull())
+	assert.True(t, state.Gauge.IsNull())
+	assert.True(t, plan.Table.Equal(state.Table), "Table block should round-trip unchanged:\nplan:  %s\nstate: %s", plan.Table, state.Table)
+}
+
+func TestGridItemToState_Heatmap(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	var params generated.GridItem_Params
+	require.NoError(t, params.FromHeatmapGridItemParams(generated.HeatmapGridItemParams{
+		Metric: "uptrace_tracing_spans",
+		Query:  "_duration",
+	}))
+	rowID := int64(7)
+	width, height := 24, 10
+	item := &generated.GridItem{
+		Id:       42,
+		DashId:   123,
+		DashKind: generated.GridItemDashKindGrid,
+		RowId:    &rowID,
+		Title:    "Latency",
+		Type:     generated.GridItemTypeHeatmap,
+		Width:    &width,
+		Height:   &height,
+		Params:   &params,
+	}
+
+	var state DashboardGridItemResourceModel
+	gridItemToState(ctx, item, &state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "7", state.RowID.ValueString())
+	assert.Equal(t, int64(24), state.Width.ValueInt64())
+	assert.Equal(t, int64(10), state.Height.ValueInt64())
+	assert.True(t, state.X.IsNull())
+	assert.True(t, state.Description.IsNull())
+
+	var heatmap GridItemHeatmapModel
+	diags.Append(state.Heatmap.As(ctx, &heatmap, basetypes.ObjectAsOptions{})...)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, "uptrace_tracing_spans", heatmap.Metric.ValueString())
+	assert.True(t, heatmap.Unit.IsNull())
+}
+
+func TestFloat32ToFloat64(t *testing.T) {
+	assert.InDelta(t, 0.1, float32ToFloat64(0.1), 0)
+	assert.InDelta(t, 1.5, float32ToFloat64(1.5), 0)
+}
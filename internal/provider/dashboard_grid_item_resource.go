@@ -0,0 +1,759 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &DashboardGridItemResource{}
+	_ resource.ResourceWithConfigure        = &DashboardGridItemResource{}
+	_ resource.ResourceWithImportState      = &DashboardGridItemResource{}
+	_ resource.ResourceWithConfigValidators = &DashboardGridItemResource{}
+)
+
+// Allowed values for grid item enums, mirroring the OpenAPI specification.
+var (
+	gridItemChartKinds     = []string{"line", "area", "bar", "stacked-area", "stacked-bar"}
+	gridItemLegendTypes    = []string{"none", "list", "table"}
+	gridItemLegendPlaces   = []string{"bottom", "right"}
+	gridItemLegendValues   = []string{"avg", "min", "max", "last"}
+	gridItemSymbols        = []string{"none", "circle", "rect", "triangle", "diamond"}
+	gridItemColumnAggFuncs = []string{"min", "max", "sum", "avg", "avg_zero", "median", "last"}
+	gridItemValueMappingOp = []string{"any", "eq", "lt", "lte", "gt", "gte"}
+)
+
+// NewDashboardGridItemResource is a helper function to create the resource.
+func NewDashboardGridItemResource() resource.Resource {
+	return &DashboardGridItemResource{}
+}
+
+// DashboardGridItemResource is the resource implementation.
+type DashboardGridItemResource struct {
+	client *client.Client
+}
+
+// DashboardGridItemResourceModel describes the resource data model.
+type DashboardGridItemResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	RowID       types.String `tfsdk:"row_id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	X           types.Int64  `tfsdk:"x"`
+	Y           types.Int64  `tfsdk:"y"`
+	Width       types.Int64  `tfsdk:"width"`
+	Height      types.Int64  `tfsdk:"height"`
+	Chart       types.Object `tfsdk:"chart"`
+	Table       types.Object `tfsdk:"table"`
+	Heatmap     types.Object `tfsdk:"heatmap"`
+	Gauge       types.Object `tfsdk:"gauge"`
+}
+
+// Metadata returns the resource type name.
+func (r *DashboardGridItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_grid_item"
+}
+
+// Schema defines the schema for the resource.
+func (r *DashboardGridItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single grid item (chart, table, heatmap or gauge) of an Uptrace dashboard. " +
+			"Exactly one of chart, table, heatmap or gauge must be configured.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Grid item identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": schema.StringAttribute{
+				Description: "Identifier of the dashboard the item belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": projectIDResourceAttribute("grid item"),
+			"row_id": schema.StringAttribute{
+				Description: "Identifier of the grid row the item is placed in. " +
+					"When omitted, the item is placed in the dashboard's table section.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Item title.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Item description.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Grid item type (chart, table, heatmap or gauge), derived from the configured block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"x": schema.Int64Attribute{
+				Description: "Horizontal position of the item in the grid.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"y": schema.Int64Attribute{
+				Description: "Vertical position of the item in the grid.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"width": schema.Int64Attribute{
+				Description: "Width of the item in grid units (0-24).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 24),
+				},
+			},
+			"height": schema.Int64Attribute{
+				Description: "Height of the item in grid units.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"chart": schema.SingleNestedAttribute{
+				Description: "Chart parameters.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnGridItemTypeChange(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"chart_kind": schema.StringAttribute{
+						Description: "Chart visualization type: 'line', 'area', 'bar', 'stacked-area' or 'stacked-bar'.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(gridItemChartKinds...),
+						},
+					},
+					"metrics": gridItemMetricsAttribute(10),
+					"query": schema.StringAttribute{
+						Description: "UQL query for the chart. Every metric alias it references must be declared in metrics.",
+						Required:    true,
+						Validators: []validator.String{
+							uqlQueryValidator{},
+						},
+					},
+					"connect_nulls": schema.BoolAttribute{
+						Description: "Whether to connect null values in the chart.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"legend": schema.SingleNestedAttribute{
+						Description: "Legend configuration.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: "Legend display type: 'none', 'list' or 'table'.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.String{
+									stringvalidator.OneOf(gridItemLegendTypes...),
+								},
+							},
+							"placement": schema.StringAttribute{
+								Description: "Legend placement: 'bottom' or 'right'.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.String{
+									stringvalidator.OneOf(gridItemLegendPlaces...),
+								},
+							},
+							"values": schema.ListAttribute{
+								Description: "Values to show in the legend: 'avg', 'min', 'max' and/or 'last'.",
+								ElementType: types.StringType,
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.List{
+									listplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(stringvalidator.OneOf(gridItemLegendValues...)),
+								},
+							},
+							"max_length": schema.Int64Attribute{
+								Description: "Maximum length of legend labels.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+						},
+					},
+					"column_map": schema.MapNestedAttribute{
+						Description: "Column configurations keyed by column name.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"unit": schema.StringAttribute{
+									Description: "Unit for the column values.",
+									Optional:    true,
+								},
+								"color": schema.StringAttribute{
+									Description: "Column color in hex format.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"timeseries_map": schema.MapNestedAttribute{
+						Description: "Timeseries styling keyed by timeseries name.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"color": schema.StringAttribute{
+									Description: "Line color in hex format.",
+									Optional:    true,
+								},
+								"opacity": schema.Int64Attribute{
+									Description: "Opacity (0-10).",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.Between(0, 10),
+									},
+								},
+								"line_width": schema.Float64Attribute{
+									Description: "Line width in pixels.",
+									Optional:    true,
+								},
+								"symbol": schema.StringAttribute{
+									Description: "Symbol type for data points: 'none', 'circle', 'rect', 'triangle' or 'diamond'.",
+									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(gridItemSymbols...),
+									},
+								},
+								"symbol_size": schema.Int64Attribute{
+									Description: "Symbol size in pixels.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"table": schema.SingleNestedAttribute{
+				Description: "Table parameters.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnGridItemTypeChange(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"metrics": gridItemMetricsAttribute(10),
+					"query": schema.StringAttribute{
+						Description: "UQL query for the table. Every metric alias it references must be declared in metrics.",
+						Required:    true,
+						Validators: []validator.String{
+							uqlQueryValidator{},
+						},
+					},
+					"column_map": schema.MapNestedAttribute{
+						Description: "Column configurations keyed by column name.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"unit": schema.StringAttribute{
+									Description: "Unit for the column values.",
+									Optional:    true,
+								},
+								"color": schema.StringAttribute{
+									Description: "Column color in hex format.",
+									Optional:    true,
+								},
+								"agg_func": schema.StringAttribute{
+									Description: "Aggregation function for table display: 'min', 'max', 'sum', 'avg', 'avg_zero', 'median' or 'last'.",
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+									Validators: []validator.String{
+										stringvalidator.OneOf(gridItemColumnAggFuncs...),
+									},
+								},
+								"sparkline_disabled": schema.BoolAttribute{
+									Description: "Whether the sparkline visualization is disabled.",
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: []planmodifier.Bool{
+										boolplanmodifier.UseStateForUnknown(),
+									},
+								},
+							},
+						},
+					},
+					"items_per_page": schema.Int64Attribute{
+						Description: "Number of rows per page.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"dense_table": schema.BoolAttribute{
+						Description: "Whether to use a dense table layout.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"heatmap": schema.SingleNestedAttribute{
+				Description: "Heatmap parameters.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnGridItemTypeChange(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"metric": schema.StringAttribute{
+						Description: "Histogram metric to display.",
+						Required:    true,
+					},
+					"query": schema.StringAttribute{
+						Description: "UQL query for the heatmap.",
+						Required:    true,
+					},
+					"unit": schema.StringAttribute{
+						Description: "Unit for the metric.",
+						Optional:    true,
+					},
+				},
+			},
+			"gauge": schema.SingleNestedAttribute{
+				Description: "Gauge parameters.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceOnGridItemTypeChange(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"metrics": gridItemMetricsAttribute(0),
+					"query": schema.StringAttribute{
+						Description: "UQL query for the gauge. Every metric alias it references must be declared in metrics.",
+						Required:    true,
+						Validators: []validator.String{
+							uqlQueryValidator{},
+						},
+					},
+					"column_map": schema.MapNestedAttribute{
+						Description: "Column configurations keyed by column name.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"unit": schema.StringAttribute{
+									Description: "Unit for the gauge value.",
+									Optional:    true,
+								},
+								"agg_func": schema.StringAttribute{
+									Description: "Aggregation function for gauge display: 'min', 'max', 'sum', 'avg', 'avg_zero', 'median' or 'last'.",
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+									Validators: []validator.String{
+										stringvalidator.OneOf(gridItemColumnAggFuncs...),
+									},
+								},
+							},
+						},
+					},
+					"template": schema.StringAttribute{
+						Description: "Template for the gauge display.",
+						Optional:    true,
+					},
+					"value_mappings": schema.ListNestedAttribute{
+						Description: "Value-to-text/color mappings.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"op": schema.StringAttribute{
+									Description: "Comparison operator: 'any', 'eq', 'lt', 'lte', 'gt' or 'gte'.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(gridItemValueMappingOp...),
+									},
+								},
+								"value": schema.Float64Attribute{
+									Description: "Value to compare against.",
+									Optional:    true,
+								},
+								"text": schema.StringAttribute{
+									Description: "Text to display for this mapping.",
+									Optional:    true,
+								},
+								"color": schema.StringAttribute{
+									Description: "Color for this mapping in hex format.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// gridItemMetricsAttribute returns the schema of the metrics list shared by grid item types.
+// A maxItems of 0 means the list size is not capped.
+func gridItemMetricsAttribute(maxItems int) schema.ListNestedAttribute {
+	validators := []validator.List{listvalidator.SizeAtLeast(1)}
+	if maxItems > 0 {
+		validators = []validator.List{listvalidator.SizeBetween(1, maxItems)}
+	}
+
+	return schema.ListNestedAttribute{
+		Description: "Metrics to display, each with the alias used in the query.",
+		Required:    true,
+		Validators:  validators,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Metric name (e.g., 'uptrace_tracing_spans').",
+					Required:    true,
+				},
+				"alias": schema.StringAttribute{
+					Description: "Metric alias used in the query (e.g., 'spans').",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceOnGridItemTypeChange forces replacement when a type block is added to or removed
+// from an existing item, since Uptrace does not change the type of a grid item in place.
+func requiresReplaceOnGridItemTypeChange() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.State.Raw.IsNull() {
+				return
+			}
+			resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		"Changing the grid item type requires replacement.",
+		"Changing the grid item type requires replacement.",
+	)
+}
+
+// ConfigValidators returns the resource-level validators.
+func (r *DashboardGridItemResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("chart"),
+			path.MatchRoot("table"),
+			path.MatchRoot("heatmap"),
+			path.MatchRoot("gauge"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DashboardGridItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = uptraceClient
+}
+
+// Create creates the resource and sets the initial Terraform state.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardGridItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating dashboard grid item", map[string]any{
+		"dashboard_id": plan.DashboardID.ValueString(),
+		"title":        plan.Title.ValueString(),
+	})
+
+	dashboardID, ok := parseDashboardID(plan.DashboardID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+	apiClient := clientForProject(r.client, plan.ProjectID)
+
+	// Convert plan to API input
+	input := planToGridItem(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create grid item via API
+	item, err := apiClient.CreateGridItem(ctx, dashboardID, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Dashboard Grid Item",
+			fmt.Sprintf("Could not create grid item in dashboard %s: %s", plan.DashboardID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Convert API response to state
+	gridItemToState(ctx, item, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Successfully created dashboard grid item", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardGridItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading dashboard grid item", map[string]any{"id": state.ID.ValueString()})
+
+	dashboardID, itemID, ok := parseGridItemIDs(state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	state.ProjectID = resolvedProjectID(r.client, state.ProjectID)
+	apiClient := clientForProject(r.client, state.ProjectID)
+
+	// Get grid item from API
+	item, err := apiClient.GetGridItem(ctx, dashboardID, itemID)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Warn(ctx, "Dashboard grid item not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Dashboard Grid Item",
+			fmt.Sprintf("Could not read grid item ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Convert API response to state
+	gridItemToState(ctx, item, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardGridItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating dashboard grid item", map[string]any{"id": plan.ID.ValueString()})
+
+	dashboardID, itemID, ok := parseGridItemIDs(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := clientForProject(r.client, plan.ProjectID)
+
+	// Convert plan to API input
+	input := planToGridItem(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update grid item via API
+	item, err := apiClient.UpdateGridItem(ctx, dashboardID, itemID, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dashboard Grid Item",
+			fmt.Sprintf("Could not update grid item ID %s: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Convert API response to state
+	gridItemToState(ctx, item, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Successfully updated dashboard grid item", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardGridItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting dashboard grid item", map[string]any{"id": state.ID.ValueString()})
+
+	dashboardID, itemID, ok := parseGridItemIDs(state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Delete grid item via API
+	err := clientForProject(r.client, state.ProjectID).DeleteGridItem(ctx, dashboardID, itemID)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Warn(ctx, "Dashboard grid item already deleted", map[string]any{"id": state.ID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Dashboard Grid Item",
+			fmt.Sprintf("Could not delete grid item ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Successfully deleted dashboard grid item", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state using an ID of the form
+// "[<project_id>/]<dashboard_id>/<item_id>".
+func (r *DashboardGridItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, dashboardID, itemID, ok := parseDashboardChildImportID(req.ID, "item", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), dashboardID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), itemID)...)
+	if !projectID.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+}
+
+// parseGridItemIDs parses the dashboard and item IDs of a grid item model.
+//
+//nolint:gocritic // Model passed by value to keep function signatures consistent
+func parseGridItemIDs(model DashboardGridItemResourceModel, diags *diag.Diagnostics) (dashboardID, itemID int64, ok bool) {
+	dashboardID, ok = parseDashboardID(model.DashboardID.ValueString(), diags)
+	if !ok {
+		return 0, 0, false
+	}
+
+	itemID, err := strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddError(
+			"Invalid Grid Item ID",
+			fmt.Sprintf("Could not parse grid item ID %s: %s", model.ID.ValueString(), err.Error()),
+		)
+		return 0, 0, false
+	}
+
+	return dashboardID, itemID, true
+}
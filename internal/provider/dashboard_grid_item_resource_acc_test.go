@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccDashboardGridItemResource_Chart(t *testing.T) {
+	resourceName := "uptrace_dashboard_grid_item.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-grid-item")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardGridItemDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDashboardGridItemResourceConfigChart(dashboardName, "Requests", "line", 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "Requests"),
+					resource.TestCheckResourceAttr(resourceName, "type", "chart"),
+					resource.TestCheckResourceAttr(resourceName, "width", "12"),
+					resource.TestCheckResourceAttr(resourceName, "chart.chart_kind", "line"),
+					resource.TestCheckResourceAttr(resourceName, "chart.metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "chart.metrics.0.alias", "spans"),
+					resource.TestCheckResourceAttrPair(resourceName, "row_id", "uptrace_dashboard_grid_row.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDashboardGridRowImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccDashboardGridItemResourceConfigChart(dashboardName, "Requests per minute", "stacked-bar", 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "Requests per minute"),
+					resource.TestCheckResourceAttr(resourceName, "width", "24"),
+					resource.TestCheckResourceAttr(resourceName, "chart.chart_kind", "stacked-bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardGridItemResource_Table(t *testing.T) {
+	resourceName := "uptrace_dashboard_grid_item.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-grid-item-table")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardGridItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardGridItemResourceConfigTable(dashboardName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "table"),
+					resource.TestCheckNoResourceAttr(resourceName, "row_id"),
+					resource.TestCheckResourceAttr(resourceName, "table.column_map.count($spans).agg_func", "sum"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardGridItemResource_InvalidWidth(t *testing.T) {
+	dashboardName := acceptancetests.RandomTestName("tf-acc-grid-item-width")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardGridItemResourceConfigChart(dashboardName, "Too wide", "line", 25),
+				ExpectError: regexp.MustCompile(`Attribute width value must be between 0 and 24`),
+			},
+		},
+	})
+}
+
+// Helper functions
+
+func testAccCheckDashboardGridItemExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No grid item ID is set")
+		}
+
+		dashboardID, itemID, err := parseGridItemTestIDs(rs)
+		if err != nil {
+			return err
+		}
+
+		client := acceptancetests.GetTestClient()
+		_, err = client.GetGridItem(context.Background(), dashboardID, itemID)
+		if err != nil {
+			return fmt.Errorf("Grid item %s not found: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardGridItemDestroy(s *terraform.State) error {
+	client := acceptancetests.GetTestClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "uptrace_dashboard_grid_item" {
+			continue
+		}
+
+		dashboardID, itemID, err := parseGridItemTestIDs(rs)
+		if err != nil {
+			continue // Skip invalid IDs
+		}
+
+		_, err = client.GetGridItem(context.Background(), dashboardID, itemID)
+		if err == nil {
+			return fmt.Errorf("Grid item %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func parseGridItemTestIDs(rs *terraform.ResourceState) (dashboardID, itemID int64, err error) {
+	dashboardID, err = strconv.ParseInt(rs.Primary.Attributes["dashboard_id"], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid dashboard ID %s: %w", rs.Primary.Attributes["dashboard_id"], err)
+	}
+
+	itemID, err = strconv.ParseInt(rs.Primary.ID, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid grid item ID %s: %w", rs.Primary.ID, err)
+	}
+
+	return dashboardID, itemID, nil
+}
+
+// Test configurations
+
+func testAccDashboardGridItemResourceConfigChart(dashboardName, title, chartKind string, width int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+  YAML
+}
+
+resource "uptrace_dashboard_grid_row" "test" {
+  dashboard_id = uptrace_dashboard.test.id
+  title        = "Traffic"
+}
+
+resource "uptrace_dashboard_grid_item" "test" {
+  dashboard_id = uptrace_dashboard.test.id
+  row_id       = uptrace_dashboard_grid_row.test.id
+  title        = %q
+  width        = %d
+  height       = 14
+
+  chart = {
+    chart_kind = %q
+    metrics = [
+      { name = "uptrace_tracing_spans", alias = "spans" },
+    ]
+    query = "per_min(sum($spans))"
+  }
+}
+`, acceptancetests.GetTestProviderConfig(), dashboardName, title, width, chartKind)
+}
+
+func testAccDashboardGridItemResourceConfigTable(dashboardName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+  YAML
+}
+
+resource "uptrace_dashboard_grid_item" "test" {
+  dashboard_id = uptrace_dashboard.test.id
+  title        = "Top services"
+
+  table = {
+    metrics = [
+      { name = "uptrace_tracing_spans", alias = "spans" },
+    ]
+    query = "group by service_name | count($spans)"
+
+    column_map = {
+      "count($spans)" = {
+        unit     = "short"
+        agg_func = "sum"
+      }
+    }
+  }
+}
+`, acceptancetests.GetTestProviderConfig(), dashboardName)
+}
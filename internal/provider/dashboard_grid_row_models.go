@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// planToGridRowCreateInput converts a Terraform plan to an API CreateGridRow request body.
+//
+//nolint:gocritic // Plan passed by value to keep function signatures consistent
+func planToGridRowCreateInput(plan DashboardGridRowResourceModel) generated.CreateGridRowJSONRequestBody {
+	title := plan.Title.ValueString()
+	input := generated.CreateGridRowJSONRequestBody{
+		Title: &title,
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		input.Description = &description
+	}
+
+	if !plan.Expanded.IsNull() && !plan.Expanded.IsUnknown() {
+		expanded := plan.Expanded.ValueBool()
+		input.Expanded = &expanded
+	}
+
+	return input
+}
+
+// planToGridRow converts a Terraform plan to an API GridRow for updates.
+// The index is taken from the current row so ordering is only changed through the move endpoints.
+//
+//nolint:gocritic // Plan passed by value to keep function signatures consistent
+func planToGridRow(plan DashboardGridRowResourceModel, current *generated.GridRow) generated.GridRow {
+	row := generated.GridRow{
+		Id:     current.Id,
+		DashId: current.DashId,
+		Title:  plan.Title.ValueString(),
+		Index:  current.Index,
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		row.Description = &description
+	}
+
+	if !plan.Expanded.IsNull() && !plan.Expanded.IsUnknown() {
+		expanded := plan.Expanded.ValueBool()
+		row.Expanded = &expanded
+	}
+
+	return row
+}
+
+// gridRowToState converts an API GridRow to Terraform state.
+func gridRowToState(row *generated.GridRow, state *DashboardGridRowResourceModel) {
+	state.ID = types.StringValue(fmt.Sprintf("%d", row.Id))
+	state.DashboardID = types.StringValue(fmt.Sprintf("%d", row.DashId))
+	state.Title = types.StringValue(row.Title)
+	state.Index = types.Int64Value(int64(row.Index))
+
+	if row.Description != nil && *row.Description != "" {
+		state.Description = types.StringValue(*row.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+
+	// Rows are expanded unless the API says otherwise
+	if row.Expanded != nil {
+		state.Expanded = types.BoolValue(*row.Expanded)
+	} else {
+		state.Expanded = types.BoolValue(true)
+	}
+
+	if row.CreatedAt != nil {
+		state.CreatedAt = types.StringValue(fmt.Sprintf("%.0f", *row.CreatedAt))
+	} else {
+		state.CreatedAt = types.StringNull()
+	}
+
+	if row.UpdatedAt != nil {
+		state.UpdatedAt = types.StringValue(fmt.Sprintf("%.0f", *row.UpdatedAt))
+	} else {
+		state.UpdatedAt = types.StringNull()
+	}
+}
+
+// parseDashboardChildImportID parses an import ID of the form "[<project_id>/]<dashboard_id>/<child_id>".
+// Returns the project ID (null without a project prefix), the dashboard ID and child ID strings
+// and a boolean indicating success.
+func parseDashboardChildImportID(
+	importID, childName string,
+	diags *diag.Diagnostics,
+) (projectID types.Int64, dashboardID, childID string, ok bool) {
+	projectID = types.Int64Null()
+
+	parts := strings.Split(importID, "/")
+	if len(parts) == 3 {
+		id, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || id <= 0 {
+			parts = nil
+		} else {
+			projectID = types.Int64Value(id)
+			parts = parts[1:]
+		}
+	}
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format <dashboard_id>/<%s_id> or <project_id>/<dashboard_id>/<%s_id>, got: %q",
+				childName, childName, importID),
+		)
+		return types.Int64Null(), "", "", false
+	}
+	return projectID, parts[0], parts[1], true
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+func TestGridRowToState_Complete(t *testing.T) {
+	description := "Request and error rates"
+	expanded := false
+	createdAt := float64(1704067200000)
+
+	row := &generated.GridRow{
+		Id:          7,
+		DashId:      123,
+		Title:       "Traffic",
+		Description: &description,
+		Expanded:    &expanded,
+		Index:       2,
+		CreatedAt:   &createdAt,
+	}
+
+	var state DashboardGridRowResourceModel
+	gridRowToState(row, &state)
+
+	assert.Equal(t, "7", state.ID.ValueString())
+	assert.Equal(t, "123", state.DashboardID.ValueString())
+	assert.Equal(t, "Traffic", state.Title.ValueString())
+	assert.Equal(t, "Request and error rates", state.Description.ValueString())
+	assert.False(t, state.Expanded.ValueBool())
+	assert.Equal(t, int64(2), state.Index.ValueInt64())
+	assert.Equal(t, "1704067200000", state.CreatedAt.ValueString())
+	assert.True(t, state.UpdatedAt.IsNull())
+}
+
+func TestGridRowToState_Defaults(t *testing.T) {
+	empty := ""
+	row := &generated.GridRow{
+		Id:          8,
+		DashId:      123,
+		Title:       "Resources",
+		Description: &empty,
+	}
+
+	var state DashboardGridRowResourceModel
+	gridRowToState(row, &state)
+
+	assert.True(t, state.Description.IsNull(), "Empty description should be null")
+	assert.True(t, state.Expanded.ValueBool(), "Expanded should default to true")
+	assert.Equal(t, int64(0), state.Index.ValueInt64())
+}
+
+func TestPlanToGridRow_KeepsCurrentIndex(t *testing.T) {
+	plan := DashboardGridRowResourceModel{
+		Title:       types.StringValue("Renamed"),
+		Description: types.StringNull(),
+		Expanded:    types.BoolValue(true),
+		Index:       types.Int64Value(0),
+	}
+	current := &generated.GridRow{Id: 7, DashId: 123, Title: "Traffic", Index: 3}
+
+	row := planToGridRow(plan, current)
+
+	assert.Equal(t, int64(7), row.Id)
+	assert.Equal(t, int64(123), row.DashId)
+	assert.Equal(t, "Renamed", row.Title)
+	assert.Equal(t, 3, row.Index, "Index should only change through move endpoints")
+	assert.Nil(t, row.Description)
+	require.NotNil(t, row.Expanded)
+	assert.True(t, *row.Expanded)
+}
+
+func TestParseDashboardChildImportID(t *testing.T) {
+	diags := diag.Diagnostics{}
+	projectID, dashboardID, childID, ok := parseDashboardChildImportID("123/7", "row", &diags)
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	assert.True(t, projectID.IsNull())
+	assert.Equal(t, "123", dashboardID)
+	assert.Equal(t, "7", childID)
+
+	projectID, dashboardID, childID, ok = parseDashboardChildImportID("2/123/7", "row", &diags)
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.Int64Value(2), projectID)
+	assert.Equal(t, "123", dashboardID)
+	assert.Equal(t, "7", childID)
+
+	for _, invalid := range []string{"7", "123/", "/7", "0/2/3", "a/2/3", "1/2/", "1/2/3/4"} {
+		diags := diag.Diagnostics{}
+		_, _, _, ok := parseDashboardChildImportID(invalid, "row", &diags)
+		assert.False(t, ok, "Import ID %q should be rejected", invalid)
+		assert.True(t, diags.HasError())
+	}
+}
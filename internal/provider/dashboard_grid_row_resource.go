@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DashboardGridRowResource{}
+	_ resource.ResourceWithConfigure   = &DashboardGridRowResource{}
+	_ resource.ResourceWithImportState = &DashboardGridRowResource{}
+)
+
+// NewDashboardGridRowResource is a helper function to create the resource.
+func NewDashboardGridRowResource() resource.Resource {
+	return &DashboardGridRowResource{}
+}
+
+// DashboardGridRowResource is the resource implementation.
+type DashboardGridRowResource struct {
+	client *client.Client
+}
+
+// DashboardGridRowResourceModel describes the resource data model.
+type DashboardGridRowResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Expanded    types.Bool   `tfsdk:"expanded"`
+	Index       types.Int64  `tfsdk:"index"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *DashboardGridRowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_grid_row"
+}
+
+// Schema defines the schema for the resource.
+func (r *DashboardGridRowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single grid row of an Uptrace dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Grid row identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": schema.StringAttribute{
+				Description: "Identifier of the dashboard the row belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": projectIDResourceAttribute("grid row"),
+			"title": schema.StringAttribute{
+				Description: "Row title.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Row description.",
+				Optional:    true,
+			},
+			"expanded": schema.BoolAttribute{
+				Description: "Whether the row is expanded.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"index": schema.Int64Attribute{
+				Description: "Zero-based position of the row in the dashboard. " +
+					"When set, the row is moved up or down until it reaches this position. " +
+					"When omitted, the position assigned by Uptrace is kept.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Row creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Row last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DashboardGridRowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = uptraceClient
+}
+
+// Create creates the resource and sets the initial Terraform state.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridRowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardGridRowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating dashboard grid row", map[string]any{
+		"dashboard_id": plan.DashboardID.ValueString(),
+		"title":        plan.Title.ValueString(),
+	})
+
+	dashboardID, ok := parseDashboardID(plan.DashboardID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+	apiClient := clientForProject(r.client, plan.ProjectID)
+
+	// Create grid row via API
+	row, err := apiClient.CreateGridRow(ctx, dashboardID, planToGridRowCreateInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Dashboard Grid Row",
+			fmt.Sprintf("Could not create grid row in dashboard %s: %s", plan.DashboardID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Move the row into the configured position
+	row = moveGridRowToIndex(ctx, apiClient, dashboardID, row, plan.Index, &resp.Diagnostics)
+
+	// Convert API response to state (also on move failure so the row is not orphaned)
+	gridRowToState(row, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Successfully created dashboard grid row", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridRowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardGridRowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading dashboard grid row", map[string]any{"id": state.ID.ValueString()})
+
+	dashboardID, rowID, ok := parseGridRowIDs(state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	state.ProjectID = resolvedProjectID(r.client, state.ProjectID)
+	apiClient := clientForProject(r.client, state.ProjectID)
+
+	// Get grid row from API
+	row, err := apiClient.GetGridRow(ctx, dashboardID, rowID)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Warn(ctx, "Dashboard grid row not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Dashboard Grid Row",
+			fmt.Sprintf("Could not read grid row ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Convert API response to state
+	gridRowToState(row, &state)
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridRowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardGridRowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating dashboard grid row", map[string]any{"id": plan.ID.ValueString()})
+
+	dashboardID, rowID, ok := parseGridRowIDs(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := clientForProject(r.client, plan.ProjectID)
+
+	// Fetch the current row so the update keeps its position
+	current, err := apiClient.GetGridRow(ctx, dashboardID, rowID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dashboard Grid Row",
+			fmt.Sprintf("Could not read grid row ID %s: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update grid row via API
+	row, err := apiClient.UpdateGridRow(ctx, dashboardID, rowID, planToGridRow(plan, current))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dashboard Grid Row",
+			fmt.Sprintf("Could not update grid row ID %s: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Move the row into the configured position
+	row = moveGridRowToIndex(ctx, apiClient, dashboardID, row, plan.Index, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert API response to state
+	gridRowToState(row, &plan)
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Successfully updated dashboard grid row", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *DashboardGridRowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardGridRowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting dashboard grid row", map[string]any{"id": state.ID.ValueString()})
+
+	dashboardID, rowID, ok := parseGridRowIDs(state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Delete grid row via API
+	err := clientForProject(r.client, state.ProjectID).DeleteGridRow(ctx, dashboardID, rowID)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Warn(ctx, "Dashboard grid row already deleted", map[string]any{"id": state.ID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Dashboard Grid Row",
+			fmt.Sprintf("Could not delete grid row ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Successfully deleted dashboard grid row", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state using an ID of the form
+// "[<project_id>/]<dashboard_id>/<row_id>".
+func (r *DashboardGridRowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, dashboardID, rowID, ok := parseDashboardChildImportID(req.ID, "row", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), dashboardID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rowID)...)
+	if !projectID.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+}
+
+// moveGridRowToIndex moves a grid row up or down until it reaches the target index.
+// It returns the row as last reported by the API.
+func moveGridRowToIndex(
+	ctx context.Context,
+	apiClient *client.Client,
+	dashboardID int64,
+	row *generated.GridRow,
+	target types.Int64,
+	diags *diag.Diagnostics,
+) *generated.GridRow {
+	if target.IsNull() || target.IsUnknown() {
+		return row
+	}
+
+	want := int(target.ValueInt64())
+	for row.Index != want {
+		tflog.Debug(ctx, "Moving dashboard grid row", map[string]any{
+			"id":      row.Id,
+			"current": row.Index,
+			"target":  want,
+		})
+
+		var moved *generated.GridRow
+		var err error
+		if want < row.Index {
+			moved, err = apiClient.MoveGridRowUp(ctx, dashboardID, row.Id)
+		} else {
+			moved, err = apiClient.MoveGridRowDown(ctx, dashboardID, row.Id)
+		}
+		if err != nil {
+			diags.AddError(
+				"Error Moving Dashboard Grid Row",
+				fmt.Sprintf("Could not move grid row ID %d to index %d: %s", row.Id, want, err.Error()),
+			)
+			return row
+		}
+
+		// The row is already at the top or bottom of the dashboard
+		if moved.Index == row.Index {
+			diags.AddAttributeError(
+				path.Root("index"),
+				"Invalid Dashboard Grid Row Index",
+				fmt.Sprintf("Grid row ID %d cannot be moved past index %d; the dashboard does not have enough rows for index %d.",
+					row.Id, row.Index, want),
+			)
+			return moved
+		}
+
+		row = moved
+	}
+
+	return row
+}
+
+// parseGridRowIDs parses the dashboard and row IDs of a grid row model.
+//
+//nolint:gocritic // Model passed by value to keep function signatures consistent
+func parseGridRowIDs(model DashboardGridRowResourceModel, diags *diag.Diagnostics) (dashboardID, rowID int64, ok bool) {
+	dashboardID, ok = parseDashboardID(model.DashboardID.ValueString(), diags)
+	if !ok {
+		return 0, 0, false
+	}
+
+	rowID, err := strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddError(
+			"Invalid Grid Row ID",
+			fmt.Sprintf("Could not parse grid row ID %s: %s", model.ID.ValueString(), err.Error()),
+		)
+		return 0, 0, false
+	}
+
+	return dashboardID, rowID, true
+}
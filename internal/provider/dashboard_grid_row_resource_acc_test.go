@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccDashboardGridRowResource_Basic(t *testing.T) {
+	resourceName := "uptrace_dashboard_grid_row.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-grid-row")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardGridRowDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDashboardGridRowResourceConfig(dashboardName, "Traffic", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridRowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "Traffic"),
+					resource.TestCheckResourceAttr(resourceName, "expanded", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_id", "uptrace_dashboard.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "index"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDashboardGridRowImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccDashboardGridRowResourceConfig(dashboardName, "Traffic Updated", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridRowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "Traffic Updated"),
+					resource.TestCheckResourceAttr(resourceName, "expanded", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardGridRowResource_Ordering(t *testing.T) {
+	dashboardName := acceptancetests.RandomTestName("tf-acc-grid-row-order")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardGridRowDestroy,
+		Steps: []resource.TestStep{
+			// Move the new row to the top of the dashboard
+			{
+				Config: testAccDashboardGridRowResourceConfigIndex(dashboardName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardGridRowExists("uptrace_dashboard_grid_row.test"),
+					resource.TestCheckResourceAttr("uptrace_dashboard_grid_row.test", "index", "0"),
+				),
+			},
+			// Move it back down
+			{
+				Config: testAccDashboardGridRowResourceConfigIndex(dashboardName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptrace_dashboard_grid_row.test", "index", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Helper functions
+
+func testAccDashboardGridRowImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["dashboard_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckDashboardGridRowExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No grid row ID is set")
+		}
+
+		dashboardID, rowID, err := parseGridRowTestIDs(rs)
+		if err != nil {
+			return err
+		}
+
+		client := acceptancetests.GetTestClient()
+		_, err = client.GetGridRow(context.Background(), dashboardID, rowID)
+		if err != nil {
+			return fmt.Errorf("Grid row %s not found: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardGridRowDestroy(s *terraform.State) error {
+	client := acceptancetests.GetTestClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "uptrace_dashboard_grid_row" {
+			continue
+		}
+
+		dashboardID, rowID, err := parseGridRowTestIDs(rs)
+		if err != nil {
+			continue // Skip invalid IDs
+		}
+
+		_, err = client.GetGridRow(context.Background(), dashboardID, rowID)
+		if err == nil {
+			return fmt.Errorf("Grid row %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func parseGridRowTestIDs(rs *terraform.ResourceState) (dashboardID, rowID int64, err error) {
+	dashboardID, err = strconv.ParseInt(rs.Primary.Attributes["dashboard_id"], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid dashboard ID %s: %w", rs.Primary.Attributes["dashboard_id"], err)
+	}
+
+	rowID, err = strconv.ParseInt(rs.Primary.ID, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid grid row ID %s: %w", rs.Primary.ID, err)
+	}
+
+	return dashboardID, rowID, nil
+}
+
+// Test configurations
+
+func testAccDashboardGridRowResourceConfig(dashboardName, title string, expanded bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+    grid_rows:
+      - title: Metrics
+        items:
+          - title: CPU Usage
+            metrics:
+              - system.cpu.utilization as $cpu
+            query:
+              - avg($cpu)
+  YAML
+}
+
+resource "uptrace_dashboard_grid_row" "test" {
+  dashboard_id = uptrace_dashboard.test.id
+  title        = %q
+  description  = "Managed by Terraform"
+  expanded     = %t
+}
+`, acceptancetests.GetTestProviderConfig(), dashboardName, title, expanded)
+}
+
+func testAccDashboardGridRowResourceConfigIndex(dashboardName string, index int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+    grid_rows:
+      - title: Metrics
+        items:
+          - title: CPU Usage
+            metrics:
+              - system.cpu.utilization as $cpu
+            query:
+              - avg($cpu)
+  YAML
+}
+
+resource "uptrace_dashboard_grid_row" "test" {
+  dashboard_id = uptrace_dashboard.test.id
+  title        = "Ordered"
+  index        = %d
+}
+`, acceptancetests.GetTestProviderConfig(), dashboardName, index)
+}
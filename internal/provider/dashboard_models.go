@@ -21,7 +21,7 @@ func dashboardToState(
 ) {
 	state.ID = types.StringValue(fmt.Sprintf("%d", dashboard.Id))
 	state.Name = types.StringValue(dashboard.Name)
-	state.YAML = types.StringValue(yamlContent)
+	state.YAML = NewDashboardYAMLValue(yamlContent)
 
 	// Set pinned field (default to false if not provided)
 	if dashboard.Pinned != nil {
@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
 	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
 )
 
@@ -165,28 +167,20 @@ func TestIsNotFoundError_True(t *testing.T) {
 		error error
 	}{
 		{
-			name:  "exact match",
-			error: fmt.Errorf("not found"),
-		},
-		{
-			name:  "with prefix",
-			error: fmt.Errorf("resource not found"),
-		},
-		{
-			name:  "with suffix",
-			error: fmt.Errorf("not found: dashboard does not exist"),
+			name:  "sentinel",
+			error: client.ErrNotFound,
 		},
 		{
-			name:  "in middle",
-			error: fmt.Errorf("error: not found in database"),
+			name:  "wrapped sentinel",
+			error: fmt.Errorf("grid row 1 does not exist in dashboard 2: %w", client.ErrNotFound),
 		},
 		{
-			name:  "uppercase",
-			error: fmt.Errorf("NOT FOUND"),
+			name:  "API error",
+			error: &client.APIError{StatusCode: http.StatusNotFound, Message: "Dashboard not found"},
 		},
 		{
-			name:  "mixed case",
-			error: fmt.Errorf("Resource Not Found"),
+			name:  "wrapped API error",
+			error: fmt.Errorf("reading dashboard: %w", &client.APIError{StatusCode: http.StatusNotFound}),
 		},
 	}
 
@@ -208,16 +202,20 @@ func TestIsNotFoundError_False(t *testing.T) {
 			error: nil,
 		},
 		{
-			name:  "different error",
-			error: fmt.Errorf("bad request"),
+			name:  "message mentioning not found",
+			error: &client.APIError{StatusCode: http.StatusBadRequest, Message: "metric system.cpu.utilization not found"},
+		},
+		{
+			name:  "plain error mentioning not found",
+			error: fmt.Errorf("not found"),
 		},
 		{
 			name:  "unauthorized",
-			error: fmt.Errorf("unauthorized"),
+			error: &client.APIError{StatusCode: http.StatusUnauthorized},
 		},
 		{
 			name:  "internal server error",
-			error: fmt.Errorf("internal server error"),
+			error: &client.APIError{StatusCode: http.StatusInternalServerError},
 		},
 	}
 
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,13 @@ type DashboardResource struct {
 
 // DashboardResourceModel describes the resource data model.
 type DashboardResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	YAML      types.String `tfsdk:"yaml"`
-	Pinned    types.Bool   `tfsdk:"pinned"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID        types.String       `tfsdk:"id"`
+	ProjectID types.Int64        `tfsdk:"project_id"`
+	Name      types.String       `tfsdk:"name"`
+	YAML      DashboardYAMLValue `tfsdk:"yaml"`
+	Pinned    types.Bool         `tfsdk:"pinned"`
+	CreatedAt types.String       `tfsdk:"created_at"`
+	UpdatedAt types.String       `tfsdk:"updated_at"`
 }
 
 // Metadata returns the resource type name.
@@ -59,17 +63,29 @@ func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": projectIDResourceAttribute("dashboard"),
 			"name": schema.StringAttribute{
 				Description: "Dashboard name (extracted from YAML or API response).",
 				Computed:    true,
 			},
 			"yaml": schema.StringAttribute{
-				Description: "Dashboard YAML definition. Supports all dashboard features including grid layout, charts, tables, heatmaps, and gauges.",
-				Required:    true,
+				Description: "Dashboard YAML definition. Supports all dashboard features including grid layout, charts, tables, heatmaps, and gauges. " +
+					"Differences in key order, whitespace and values filled in by Uptrace are not reported as changes. " +
+					"Chart queries are checked to be UQL queries that only reference metric aliases declared in the item's metrics.",
+				CustomType: DashboardYAMLType{},
+				Required:   true,
+				Validators: []validator.String{
+					dashboardYAMLQueryValidator{},
+				},
 			},
 			"pinned": schema.BoolAttribute{
-				Description: "Whether the dashboard is pinned.",
-				Computed:    true,
+				Description: "Whether the dashboard is pinned to the top of the dashboard list. " +
+					"When omitted, the current pinned status is left unchanged.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Dashboard creation timestamp.",
@@ -114,7 +130,9 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Info(ctx, "Creating dashboard", map[string]any{"yaml_length": len(plan.YAML.ValueString())})
 
 	// Create dashboard via API
-	dashboard, err := r.client.CreateDashboardFromYAML(ctx, plan.YAML.ValueString())
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+	apiClient := clientForProject(r.client, plan.ProjectID)
+	dashboard, err := apiClient.CreateDashboardFromYAML(ctx, plan.YAML.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Dashboard",
@@ -123,6 +141,15 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Apply the configured pinned status
+	r.reconcilePinned(ctx, apiClient, dashboard, plan.Pinned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Keep the created dashboard in state so it is not orphaned
+		dashboardToState(ctx, dashboard, plan.YAML.ValueString(), &plan, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Convert API response to state
 	dashboardToState(ctx, dashboard, plan.YAML.ValueString(), &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -153,7 +180,9 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get dashboard from API
-	dashboard, err := r.client.GetDashboard(ctx, dashboardID)
+	state.ProjectID = resolvedProjectID(r.client, state.ProjectID)
+	apiClient := clientForProject(r.client, state.ProjectID)
+	dashboard, err := apiClient.GetDashboard(ctx, dashboardID)
 	if err != nil {
 		if isNotFoundError(err) {
 			tflog.Warn(ctx, "Dashboard not found, removing from state", map[string]any{"id": state.ID.ValueString()})
@@ -167,27 +196,15 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Only fetch YAML from API during import (when state.YAML is empty)
-	// Otherwise, preserve the user's original YAML to avoid drift from API-added defaults
-	var yamlContent string
-	if state.YAML.ValueString() == "" {
-		tflog.Debug(ctx, "Fetching dashboard YAML (import scenario)", map[string]any{"id": state.ID.ValueString()})
-		fetchedYAML, err := r.client.GetDashboardYAML(ctx, dashboardID)
-		if err != nil {
-			tflog.Error(ctx, "Could not fetch dashboard YAML during import", map[string]any{
-				"id":    state.ID.ValueString(),
-				"error": err.Error(),
-			})
-			resp.Diagnostics.AddError(
-				"Error Fetching Dashboard YAML",
-				fmt.Sprintf("Could not fetch YAML for dashboard ID %s during import: %s", state.ID.ValueString(), err.Error()),
-			)
-			return
-		}
-		yamlContent = fetchedYAML
-	} else {
-		// Preserve user's original YAML from state
-		yamlContent = state.YAML.ValueString()
+	// Always fetch the YAML so changes made outside Terraform are detected.
+	// Semantically equal YAML keeps the configured value thanks to DashboardYAMLType.
+	yamlContent, err := apiClient.GetDashboardYAML(ctx, dashboardID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Fetching Dashboard YAML",
+			fmt.Sprintf("Could not fetch YAML for dashboard ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
 	}
 
 	// Convert API response to state
@@ -220,7 +237,8 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Update dashboard via API
-	dashboard, err := r.client.UpdateDashboardFromYAML(ctx, dashboardID, plan.YAML.ValueString())
+	apiClient := clientForProject(r.client, plan.ProjectID)
+	dashboard, err := apiClient.UpdateDashboardFromYAML(ctx, dashboardID, plan.YAML.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Dashboard",
@@ -229,6 +247,12 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Apply the configured pinned status
+	r.reconcilePinned(ctx, apiClient, dashboard, plan.Pinned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert API response to state
 	dashboardToState(ctx, dashboard, plan.YAML.ValueString(), &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -259,7 +283,7 @@ func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	// Delete dashboard via API
-	err := r.client.DeleteDashboard(ctx, dashboardID)
+	err := clientForProject(r.client, state.ProjectID).DeleteDashboard(ctx, dashboardID)
 	if err != nil {
 		if isNotFoundError(err) {
 			tflog.Warn(ctx, "Dashboard already deleted", map[string]any{"id": state.ID.ValueString()})
@@ -275,7 +299,60 @@ func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 	tflog.Info(ctx, "Successfully deleted dashboard", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state.
+// reconcilePinned pins or unpins the dashboard when the planned value differs from the API.
+// On success the dashboard's Pinned field is updated to reflect the new status.
+func (r *DashboardResource) reconcilePinned(ctx context.Context, apiClient *client.Client, dashboard *generated.Dashboard, planned types.Bool, diags *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	want := planned.ValueBool()
+	current := dashboard.Pinned != nil && *dashboard.Pinned
+	if want == current {
+		return
+	}
+
+	tflog.Debug(ctx, "Reconciling dashboard pinned status", map[string]any{
+		"id":      dashboard.Id,
+		"current": current,
+		"planned": want,
+	})
+
+	var err error
+	if want {
+		err = apiClient.PinDashboard(ctx, dashboard.Id)
+	} else {
+		err = apiClient.UnpinDashboard(ctx, dashboard.Id)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Updating Dashboard Pinned Status",
+			fmt.Sprintf("Could not set pinned=%t for dashboard ID %d: %s", want, dashboard.Id, err.Error()),
+		)
+		return
+	}
+
+	dashboard.Pinned = &want
+}
+
+// ImportState imports the resource state using an ID of the form
+// "[<project_id>/](<dashboard_id>|name:<dashboard_name>)".
 func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, r.client, "dashboard", findDashboardIDsByName, req, resp)
+}
+
+// findDashboardIDsByName returns the IDs of all dashboards with the given exact name.
+func findDashboardIDsByName(ctx context.Context, apiClient *client.Client, name string) ([]int64, error) {
+	dashboards, err := apiClient.ListDashboards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for i := range dashboards {
+		if dashboards[i].Name == name {
+			ids = append(ids, dashboards[i].Id)
+		}
+	}
+	return ids, nil
 }
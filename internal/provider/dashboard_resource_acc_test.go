@@ -41,6 +41,14 @@ func TestAccDashboardResource_Basic(t *testing.T) {
 				// that differ from user's input (width, height, type, properties, etc.)
 				ImportStateVerifyIgnore: []string{"yaml", "created_at", "updated_at"},
 			},
+			// Import by name
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "name:" + dashboardName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"yaml", "created_at", "updated_at"},
+			},
 			// Update testing
 			{
 				Config: testAccDashboardResourceConfigUpdated(dashboardName + " Updated"),
@@ -185,10 +193,33 @@ func TestAccDashboardResource_PinUnpin(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "pinned", "false"),
 				),
 			},
-			// Test that pinned status can be read
+			// Pin the dashboard
 			{
-				Config: testAccDashboardResourceConfigBasic(dashboardName),
+				Config: testAccDashboardResourceConfigPinned(dashboardName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pinned", "true"),
+					testAccCheckDashboard(resourceName, dashboardName, true),
+				),
+			},
+			// Detect out-of-band unpin as drift
+			{
+				Config: testAccDashboardResourceConfigPinned(dashboardName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardUnpinned(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-pin, then explicitly unpin
+			{
+				Config: testAccDashboardResourceConfigPinned(dashboardName, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboard(resourceName, dashboardName, true),
+				),
+			},
+			{
+				Config: testAccDashboardResourceConfigPinned(dashboardName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pinned", "false"),
 					testAccCheckDashboard(resourceName, dashboardName, false),
 				),
 			},
@@ -196,6 +227,62 @@ func TestAccDashboardResource_PinUnpin(t *testing.T) {
 	})
 }
 
+func TestAccDashboardResource_CreatePinned(t *testing.T) {
+	resourceName := "uptrace_dashboard.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-dashboard-create-pinned")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfigPinned(dashboardName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pinned", "true"),
+					testAccCheckDashboard(resourceName, dashboardName, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardResource_YAMLDrift(t *testing.T) {
+	resourceName := "uptrace_dashboard.test"
+	dashboardName := acceptancetests.RandomTestName("tf-acc-dashboard-drift")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			// Create; the post-apply plan must be empty despite API-added defaults
+			{
+				Config: testAccDashboardResourceConfigBasic(dashboardName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(resourceName),
+				),
+			},
+			// Detect out-of-band YAML changes as drift
+			{
+				Config: testAccDashboardResourceConfigBasic(dashboardName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardYAMLChanged(resourceName, testAccDashboardResourceConfigUpdatedYAML(dashboardName)),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-apply restores the configured YAML
+			{
+				Config: testAccDashboardResourceConfigBasic(dashboardName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDashboardResource_Clone(t *testing.T) {
 	resourceName := "uptrace_dashboard.test"
 	dashboardName := acceptancetests.RandomTestName("tf-acc-dashboard-clone")
@@ -216,6 +303,48 @@ func TestAccDashboardResource_Clone(t *testing.T) {
 	})
 }
 
+func testAccCheckDashboardUnpinned(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		dashboardID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid dashboard ID %s: %w", rs.Primary.ID, err)
+		}
+
+		client := acceptancetests.GetTestClient()
+		if err := client.UnpinDashboard(context.Background(), dashboardID); err != nil {
+			return fmt.Errorf("Error unpinning dashboard %s: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardYAMLChanged(resourceName, yaml string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		dashboardID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid dashboard ID %s: %w", rs.Primary.ID, err)
+		}
+
+		client := acceptancetests.GetTestClient()
+		if _, err := client.UpdateDashboardFromYAML(context.Background(), dashboardID, yaml); err != nil {
+			return fmt.Errorf("Error updating dashboard %s: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
 // Test configurations
 
 func testAccCheckDashboard(resourceName, expectedName string, expectedPinned bool) resource.TestCheckFunc {
@@ -270,6 +399,29 @@ resource "uptrace_dashboard" "test" {
 `, acceptancetests.GetTestProviderConfig(), name)
 }
 
+func testAccDashboardResourceConfigPinned(name string, pinned bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_dashboard" "test" {
+  pinned = %t
+
+  yaml = <<-YAML
+    schema: v2
+    name: %s
+    grid_rows:
+      - title: Metrics
+        items:
+          - title: CPU Usage
+            metrics:
+              - system.cpu.utilization as $cpu
+            query:
+              - avg($cpu)
+  YAML
+}
+`, acceptancetests.GetTestProviderConfig(), pinned, name)
+}
+
 func testAccDashboardResourceConfigUpdated(name string) string {
 	return fmt.Sprintf(`
 %s
@@ -330,3 +482,17 @@ resource "uptrace_dashboard" "test" {
 }
 `, acceptancetests.GetTestProviderConfig(), name)
 }
+
+func testAccDashboardResourceConfigUpdatedYAML(name string) string {
+	return fmt.Sprintf(`schema: v2
+name: %s
+grid_rows:
+  - title: Metrics
+    items:
+      - title: CPU Usage (edited in UI)
+        metrics:
+          - system.cpu.utilization as $cpu
+        query:
+          - max($cpu)
+`, name)
+}
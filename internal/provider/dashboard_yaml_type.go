@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v2"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = DashboardYAMLType{}
+	_ basetypes.StringValuableWithSemanticEquals = DashboardYAMLValue{}
+)
+
+// dashboardYAMLDefaults lists keys whose value Uptrace fills in when they are omitted.
+// A key holding its default value is treated the same as a missing key.
+var dashboardYAMLDefaults = map[string]any{
+	"expanded":           true,
+	"type":               "chart",
+	"chart_kind":         "line",
+	"connect_nulls":      false,
+	"dense_table":        false,
+	"sparkline_disabled": false,
+	"time_offset":        float64(0),
+}
+
+// dashboardYAMLServerKeys lists keys Uptrace assigns on its own (mostly grid layout).
+// They are only compared when both documents set them.
+var dashboardYAMLServerKeys = map[string]bool{
+	"width":      true,
+	"height":     true,
+	"x_axis":     true,
+	"y_axis":     true,
+	"properties": true,
+}
+
+// DashboardYAMLType is a string type for dashboard YAML definitions that
+// compares values semantically rather than byte for byte.
+type DashboardYAMLType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DashboardYAMLType) String() string {
+	return "DashboardYAMLType"
+}
+
+// ValueType returns the Value type.
+func (t DashboardYAMLType) ValueType(_ context.Context) attr.Value {
+	return DashboardYAMLValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DashboardYAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(DashboardYAMLType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DashboardYAMLType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DashboardYAMLValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t DashboardYAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// DashboardYAMLValue is a dashboard YAML definition value.
+type DashboardYAMLValue struct {
+	basetypes.StringValue
+}
+
+// NewDashboardYAMLValue creates a known dashboard YAML value.
+func NewDashboardYAMLValue(value string) DashboardYAMLValue {
+	return DashboardYAMLValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the attribute type of the value.
+func (v DashboardYAMLValue) Type(_ context.Context) attr.Type {
+	return DashboardYAMLType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DashboardYAMLValue) Equal(o attr.Value) bool {
+	other, ok := o.(DashboardYAMLValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both YAML documents describe the same dashboard.
+// Key order, whitespace, empty values, Uptrace defaults and server-assigned layout keys are ignored.
+// Documents that cannot be parsed are compared as plain strings.
+func (v DashboardYAMLValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DashboardYAMLValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+
+	oldDoc, err := normalizeDashboardYAML(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newDoc, err := normalizeDashboardYAML(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return dashboardYAMLEqual(oldDoc, newDoc), diags
+}
+
+// normalizeDashboardYAML parses a YAML document into plain Go values with
+// string map keys, float64 numbers, and empty or default values removed.
+func normalizeDashboardYAML(content string) (any, error) {
+	var doc any
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse dashboard YAML: %w", err)
+	}
+	return normalizeYAMLNode(doc), nil
+}
+
+// normalizeYAMLNode recursively normalizes a decoded YAML node.
+// It returns nil for nodes that carry no information.
+func normalizeYAMLNode(node any) any {
+	switch n := node.(type) {
+	case map[any]any:
+		out := make(map[string]any, len(n))
+		for k, v := range n {
+			key := fmt.Sprint(k)
+			value := normalizeYAMLNode(v)
+			if value == nil {
+				continue
+			}
+			if def, ok := dashboardYAMLDefaults[key]; ok && reflect.DeepEqual(def, value) {
+				continue
+			}
+			out[key] = value
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []any:
+		if len(n) == 0 {
+			return nil
+		}
+		out := make([]any, len(n))
+		for i, v := range n {
+			out[i] = normalizeYAMLNode(v)
+		}
+		return out
+	case string:
+		if n == "" {
+			return nil
+		}
+		return n
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case uint64:
+		return float64(n)
+	case float32:
+		return float64(n)
+	default:
+		return n
+	}
+}
+
+// dashboardYAMLEqual compares two normalized YAML nodes. Map keys listed in
+// dashboardYAMLServerKeys are skipped when only one side sets them.
+func dashboardYAMLEqual(a, b any) bool {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, aValue := range av {
+			bValue, ok := bv[key]
+			if !ok {
+				if dashboardYAMLServerKeys[key] {
+					continue
+				}
+				return false
+			}
+			if !dashboardYAMLEqual(aValue, bValue) {
+				return false
+			}
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok && !dashboardYAMLServerKeys[key] {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !dashboardYAMLEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
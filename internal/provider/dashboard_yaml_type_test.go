@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDashboardYAML = `schema: v2
+name: Service Overview
+grid_rows:
+  - title: Traffic
+    items:
+      - title: Request Rate
+        metrics:
+          - uptrace_tracing_spans as $spans
+        query:
+          - per_min(sum($spans))
+`
+
+func TestDashboardYAMLSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		newYAML  string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			newYAML:  testDashboardYAML,
+			expected: true,
+		},
+		{
+			name: "key order and whitespace",
+			newYAML: `name:   Service Overview
+schema: v2
+
+grid_rows:
+- items:
+  - query: [per_min(sum($spans))]
+    metrics: [uptrace_tracing_spans as $spans]
+    title: Request Rate
+  title: Traffic
+`,
+			expected: true,
+		},
+		{
+			name: "server defaults and layout",
+			newYAML: `schema: v2
+name: Service Overview
+grid_query: ""
+table_items: []
+grid_rows:
+  - title: Traffic
+    description: ""
+    expanded: true
+    items:
+      - title: Request Rate
+        type: chart
+        chart_kind: line
+        width: 12
+        height: 28
+        x_axis: 0
+        y_axis: 0
+        metrics:
+          - uptrace_tracing_spans as $spans
+        query:
+          - per_min(sum($spans))
+`,
+			expected: true,
+		},
+		{
+			name:     "changed title",
+			newYAML:  `schema: v2` + "\n" + `name: Renamed Overview`,
+			expected: false,
+		},
+		{
+			name: "non-default value",
+			newYAML: `schema: v2
+name: Service Overview
+grid_rows:
+  - title: Traffic
+    expanded: false
+    items:
+      - title: Request Rate
+        metrics:
+          - uptrace_tracing_spans as $spans
+        query:
+          - per_min(sum($spans))
+`,
+			expected: false,
+		},
+		{
+			name: "added item",
+			newYAML: testDashboardYAML + `      - title: Errors
+        metrics:
+          - uptrace_tracing_spans as $spans
+        query:
+          - count($spans{_status_code="error"})
+`,
+			expected: false,
+		},
+		{
+			name:     "unparsable",
+			newYAML:  "name: [unterminated",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldValue := NewDashboardYAMLValue(testDashboardYAML)
+
+			equal, diags := oldValue.StringSemanticEquals(context.Background(), NewDashboardYAMLValue(tt.newYAML))
+
+			require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expected, equal)
+		})
+	}
+}
+
+func TestDashboardYAMLSemanticEquals_ServerKeySetOnBothSides(t *testing.T) {
+	oldValue := NewDashboardYAMLValue("name: A\ngrid_rows:\n  - items:\n      - title: X\n        width: 12\n")
+	newValue := NewDashboardYAMLValue("name: A\ngrid_rows:\n  - items:\n      - title: X\n        width: 24\n")
+
+	equal, diags := oldValue.StringSemanticEquals(context.Background(), newValue)
+
+	require.False(t, diags.HasError())
+	assert.False(t, equal, "Layout keys set on both sides should still be compared")
+}
+
+func TestNormalizeDashboardYAML_Numbers(t *testing.T) {
+	a, err := normalizeDashboardYAML("min_interval: 60")
+	require.NoError(t, err)
+	b, err := normalizeDashboardYAML("min_interval: 60.0")
+	require.NoError(t, err)
+
+	assert.True(t, dashboardYAMLEqual(a, b), "Integer and float forms of the same number should be equal")
+}
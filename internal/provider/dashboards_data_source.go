@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DashboardsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DashboardsDataSource{}
+)
+
+// NewDashboardsDataSource is a helper function to create the data source.
+func NewDashboardsDataSource() datasource.DataSource {
+	return &DashboardsDataSource{}
+}
+
+// DashboardsDataSource is the data source implementation.
+type DashboardsDataSource struct {
+	client *client.Client
+}
+
+// DashboardsDataSourceModel describes the data source data model.
+type DashboardsDataSourceModel struct {
+	ProjectID  types.Int64  `tfsdk:"project_id"`
+	Name       types.String `tfsdk:"name"`
+	Pinned     types.Bool   `tfsdk:"pinned"`
+	TemplateID types.String `tfsdk:"template_id"`
+	Dashboards types.List   `tfsdk:"dashboards"`
+}
+
+// DashboardModel describes an individual dashboard in the list.
+type DashboardModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	TemplateID types.String `tfsdk:"template_id"`
+	Pinned     types.Bool   `tfsdk:"pinned"`
+	GridQuery  types.String `tfsdk:"grid_query"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// dashboardModelAttrTypes are the attribute types of a dashboard in the list.
+var dashboardModelAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"template_id": types.StringType,
+	"pinned":      types.BoolType,
+	"grid_query":  types.StringType,
+	"created_at":  types.StringType,
+	"updated_at":  types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *DashboardsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboards"
+}
+
+// Schema defines the schema for the data source.
+func (d *DashboardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a list of Uptrace dashboards with optional filtering.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Filter dashboards by name (case-insensitive substring match).",
+				Optional:    true,
+			},
+			"pinned": schema.BoolAttribute{
+				Description: "Filter dashboards by pinned status.",
+				Optional:    true,
+			},
+			"template_id": schema.StringAttribute{
+				Description: "Filter dashboards by the template they were created from.",
+				Optional:    true,
+			},
+			"dashboards": schema.ListNestedAttribute{
+				Description: "List of dashboards matching the filter criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Dashboard identifier.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Dashboard name.",
+							Computed:    true,
+						},
+						"template_id": schema.StringAttribute{
+							Description: "Template ID if the dashboard was created from a template.",
+							Computed:    true,
+						},
+						"pinned": schema.BoolAttribute{
+							Description: "Whether the dashboard is pinned.",
+							Computed:    true,
+						},
+						"grid_query": schema.StringAttribute{
+							Description: "Global query filter applied to all grid items.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Dashboard creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Dashboard last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DashboardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = uptraceClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (d *DashboardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DashboardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading dashboards data source", map[string]any{
+		"name_filter":        config.Name.ValueString(),
+		"pinned_filter":      config.Pinned.String(),
+		"template_id_filter": config.TemplateID.ValueString(),
+	})
+
+	// Fetch all dashboards from API
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+	dashboards, err := apiClient.ListDashboards(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dashboards",
+			fmt.Sprintf("Could not list dashboards: %s", err.Error()),
+		)
+		return
+	}
+
+	// Apply filters
+	filtered := filterDashboards(dashboards, config.Name, config.Pinned, config.TemplateID)
+
+	tflog.Debug(ctx, "Filtered dashboards", map[string]any{
+		"total_count":    len(dashboards),
+		"filtered_count": len(filtered),
+	})
+
+	// Convert to Terraform state
+	dashboardModels := make([]DashboardModel, 0, len(filtered))
+	for i := range filtered {
+		dashboardModels = append(dashboardModels, convertDashboardToModel(ctx, &filtered[i], &resp.Diagnostics))
+	}
+
+	dashboardList, diags := convertDashboardModelsToList(ctx, dashboardModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Dashboards = dashboardList
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Info(ctx, "Successfully read dashboards data source", map[string]any{"count": len(dashboardModels)})
+}
+
+// filterDashboards applies filters to the dashboard list.
+func filterDashboards(dashboards []generated.Dashboard, nameFilter types.String, pinnedFilter types.Bool, templateIDFilter types.String) []generated.Dashboard {
+	var filtered []generated.Dashboard
+
+	//nolint:gocritic // Large struct copy acceptable for filtering logic
+	for _, dashboard := range dashboards {
+		// Skip if name filter doesn't match
+		if !nameFilter.IsNull() && !nameFilter.IsUnknown() {
+			if !strings.Contains(
+				strings.ToLower(dashboard.Name),
+				strings.ToLower(nameFilter.ValueString()),
+			) {
+				continue
+			}
+		}
+
+		// Skip if pinned filter doesn't match
+		if !pinnedFilter.IsNull() && !pinnedFilter.IsUnknown() {
+			pinned := dashboard.Pinned != nil && *dashboard.Pinned
+			if pinned != pinnedFilter.ValueBool() {
+				continue
+			}
+		}
+
+		// Skip if template ID filter doesn't match
+		if !templateIDFilter.IsNull() && !templateIDFilter.IsUnknown() {
+			if dashboard.TemplateId == nil || *dashboard.TemplateId != templateIDFilter.ValueString() {
+				continue
+			}
+		}
+
+		filtered = append(filtered, dashboard)
+	}
+
+	return filtered
+}
+
+// convertDashboardToModel converts an API Dashboard to DashboardModel.
+func convertDashboardToModel(ctx context.Context, dashboard *generated.Dashboard, diags *diag.Diagnostics) DashboardModel {
+	// Reuse the resource conversion for the shared fields
+	var tempModel DashboardResourceModel
+	dashboardToState(ctx, dashboard, "", &tempModel, diags)
+
+	return DashboardModel{
+		ID:         tempModel.ID,
+		Name:       tempModel.Name,
+		TemplateID: stringValueOrNull(dashboard.TemplateId),
+		Pinned:     tempModel.Pinned,
+		GridQuery:  stringValueOrNull(dashboard.GridQuery),
+		CreatedAt:  tempModel.CreatedAt,
+		UpdatedAt:  tempModel.UpdatedAt,
+	}
+}
+
+// convertDashboardModelsToList converts a slice of DashboardModel to types.List.
+func convertDashboardModelsToList(ctx context.Context, models []DashboardModel) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dashboardModelAttrTypes}, models)
+}
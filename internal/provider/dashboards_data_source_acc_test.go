@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccDashboardsDataSource_FilterByName(t *testing.T) {
+	dataSourceName := "data.uptrace_dashboards.test"
+	prefix := acceptancetests.RandomTestName("tf-acc-ds-dashboards")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardsDataSourceConfig(prefix, `name = "`+prefix+`"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Both dashboards share the random prefix
+					resource.TestCheckResourceAttr(dataSourceName, "dashboards.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "dashboards.0.name", regexp.MustCompile(regexp.QuoteMeta(prefix))),
+					resource.TestCheckResourceAttrSet(dataSourceName, "dashboards.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardsDataSource_FilterByPinned(t *testing.T) {
+	dataSourceName := "data.uptrace_dashboards.test"
+	prefix := acceptancetests.RandomTestName("tf-acc-ds-dashboards-pinned")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardsDataSourceConfig(prefix, `name = "`+prefix+`"`+"\n  pinned = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dashboards.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dashboards.0.pinned", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dashboards.0.id", "uptrace_dashboard.pinned", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardsDataSource_EmptyResults(t *testing.T) {
+	dataSourceName := "data.uptrace_dashboards.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "uptrace_dashboards" "test" {
+  name = "tf-acc-nonexistent-dashboard-xyz-12345"
+}
+`, acceptancetests.GetTestProviderConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dashboards.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDashboardsDataSourceConfig generates a config with a pinned and an unpinned dashboard.
+func testAccDashboardsDataSourceConfig(prefix, filters string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "uptrace_dashboard" "pinned" {
+  pinned = true
+
+  yaml = <<-YAML
+    schema: v2
+    name: %[2]s pinned
+  YAML
+}
+
+resource "uptrace_dashboard" "unpinned" {
+  pinned = false
+
+  yaml = <<-YAML
+    schema: v2
+    name: %[2]s unpinned
+  YAML
+}
+
+data "uptrace_dashboards" "test" {
+  %[3]s
+
+  depends_on = [
+    uptrace_dashboard.pinned,
+    uptrace_dashboard.unpinned,
+  ]
+}
+`, acceptancetests.GetTestProviderConfig(), prefix, filters)
+}
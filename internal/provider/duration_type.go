@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = DurationType{}
+	_ basetypes.StringValuableWithSemanticEquals = DurationValue{}
+	_ xattr.ValidateableAttribute                = DurationValue{}
+	_ validator.String                           = durationAtLeastValidator{}
+)
+
+// Duration types of the monitor attributes Uptrace stores as milliseconds and as seconds.
+var (
+	durationMillisecondsType = DurationType{Unit: time.Millisecond}
+	durationSecondsType      = DurationType{Unit: time.Second}
+)
+
+// DurationType is a string type for durations such as "5m" or "1h30m", which Uptrace
+// stores as a number of Unit. Plain numbers are read as a number of Unit, so
+// configurations and state written before durations were supported keep working.
+// Values are compared by the duration they represent.
+type DurationType struct {
+	basetypes.StringType
+
+	// Unit is the unit of the number Uptrace stores.
+	Unit time.Duration
+}
+
+// String returns a human readable string of the type name.
+func (t DurationType) String() string {
+	return fmt.Sprintf("DurationType[%s]", t.Unit)
+}
+
+// ValueType returns the Value type.
+func (t DurationType) ValueType(_ context.Context) attr.Value {
+	return DurationValue{unit: t.Unit}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationType)
+	if !ok {
+		return false
+	}
+	return t.Unit == other.Unit && t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DurationValue{StringValue: in, unit: t.Unit}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// DurationValue is a duration value.
+type DurationValue struct {
+	basetypes.StringValue
+
+	unit time.Duration
+}
+
+// NewDurationValue creates a known duration value of a type with the given unit.
+func NewDurationValue(value string, unit time.Duration) DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringValue(value), unit: unit}
+}
+
+// NewDurationNull creates a null duration value of a type with the given unit.
+func NewDurationNull(unit time.Duration) DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringNull(), unit: unit}
+}
+
+// NewDurationUnknown creates an unknown duration value of a type with the given unit.
+func NewDurationUnknown(unit time.Duration) DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringUnknown(), unit: unit}
+}
+
+// NewDurationValueFromUnits creates a duration value from a number of units returned by Uptrace.
+func NewDurationValueFromUnits(units float64, unit time.Duration) DurationValue {
+	return NewDurationValue(formatDuration(time.Duration(units*float64(unit))), unit)
+}
+
+// NewDurationPointerValueFromUnits creates a duration value from an optional number of units.
+// A nil number is null.
+func NewDurationPointerValueFromUnits(units *float64, unit time.Duration) DurationValue {
+	if units == nil {
+		return NewDurationNull(unit)
+	}
+	return NewDurationValueFromUnits(*units, unit)
+}
+
+// Type returns the attribute type of the value.
+func (v DurationValue) Type(_ context.Context) attr.Type {
+	return DurationType{Unit: v.unit}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DurationValue) Equal(o attr.Value) bool {
+	other, ok := o.(DurationValue)
+	if !ok {
+		return false
+	}
+	return v.unit == other.unit && v.StringValue.Equal(other.StringValue)
+}
+
+// Duration parses the value, either as a duration string or as a plain number of units.
+func (v DurationValue) Duration() (time.Duration, error) {
+	return parseDuration(v.ValueString(), v.unit)
+}
+
+// ValueUnits returns the value as the number of units Uptrace stores.
+func (v DurationValue) ValueUnits() (float64, error) {
+	d, err := v.Duration()
+	if err != nil {
+		return 0, err
+	}
+	return float64(d) / float64(v.unit), nil
+}
+
+// StringSemanticEquals returns true if both values represent the same duration,
+// so "5m", "300s" and the number 300000 in milliseconds are equal.
+// Values that cannot be parsed are compared as plain strings.
+func (v DurationValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DurationValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+
+	oldDuration, err := v.Duration()
+	if err != nil {
+		return false, diags
+	}
+	newDuration, err := newValue.Duration()
+	if err != nil {
+		return false, diags
+	}
+
+	return oldDuration == newDuration, diags
+}
+
+// ValidateAttribute checks that the value is a non-negative duration made of whole units.
+func (v DurationValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	d, err := v.Duration()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a duration such as \"5m\" or \"1h30m\", or a number of %s: %s", unitName(v.unit), err.Error()),
+		)
+		return
+	}
+
+	if d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The duration %q must not be negative.", v.ValueString()),
+		)
+		return
+	}
+
+	if d%v.unit != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The duration %q must be a whole number of %s.", v.ValueString(), unitName(v.unit)),
+		)
+	}
+}
+
+// parseDuration parses a duration string such as "1h30m", or a plain number of units.
+func parseDuration(value string, unit time.Duration) (time.Duration, error) {
+	units, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.ParseDuration(value)
+	}
+
+	d := units * float64(unit)
+	if math.IsNaN(d) || math.IsInf(d, 0) || math.Abs(d) > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return time.Duration(d), nil
+}
+
+// formatDuration formats a duration like time.Duration.String without zero minutes and
+// seconds after a larger unit, so one minute is "1m" rather than "1m0s".
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// unitName returns the plural name of a duration unit.
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Millisecond:
+		return "milliseconds"
+	case time.Second:
+		return "seconds"
+	default:
+		return "units of " + unit.String()
+	}
+}
+
+// durationAtLeast returns a validator which ensures a duration of a type with the given unit is at least minimum.
+func durationAtLeast(unit, minimum time.Duration) validator.String {
+	return durationAtLeastValidator{unit: unit, minimum: minimum}
+}
+
+// durationAtLeastValidator checks the minimum of a duration. Values that cannot be parsed
+// are reported by DurationValue.ValidateAttribute.
+type durationAtLeastValidator struct {
+	unit    time.Duration
+	minimum time.Duration
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v durationAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("duration must be at least %s", formatDuration(v.minimum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v durationAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v durationAtLeastValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := parseDuration(req.ConfigValue.ValueString(), v.unit)
+	if err != nil || d >= v.minimum {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Duration",
+		fmt.Sprintf("The duration %q must be at least %s.", req.ConfigValue.ValueString(), formatDuration(v.minimum)),
+	)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldValue string
+		newValue string
+		expected bool
+	}{
+		{name: "identical", oldValue: "5m", newValue: "5m", expected: true},
+		{name: "different units", oldValue: "5m", newValue: "300s", expected: true},
+		{name: "number of milliseconds", oldValue: "300000", newValue: "5m", expected: true},
+		{name: "compound", oldValue: "1h30m", newValue: "90m", expected: true},
+		{name: "changed", oldValue: "5m", newValue: "10m", expected: false},
+		{name: "unparsable", oldValue: "5 minutes", newValue: "5m", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldValue := NewDurationValue(tt.oldValue, time.Millisecond)
+
+			equal, diags := oldValue.StringSemanticEquals(context.Background(), NewDurationValue(tt.newValue, time.Millisecond))
+
+			require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expected, equal)
+		})
+	}
+}
+
+func TestDurationValueUnits(t *testing.T) {
+	tests := []struct {
+		value string
+		unit  time.Duration
+		units float64
+	}{
+		{value: "5m", unit: time.Millisecond, units: 300000},
+		{value: "60000", unit: time.Millisecond, units: 60000},
+		{value: "1h30m", unit: time.Second, units: 5400},
+		{value: "300", unit: time.Second, units: 300},
+		{value: "0s", unit: time.Millisecond, units: 0},
+	}
+
+	for _, tt := range tests {
+		units, err := NewDurationValue(tt.value, tt.unit).ValueUnits()
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.units, units, tt.value)
+	}
+}
+
+func TestNewDurationValueFromUnits(t *testing.T) {
+	assert.Equal(t, NewDurationValue("1m", time.Millisecond), NewDurationValueFromUnits(60000, time.Millisecond))
+	assert.Equal(t, NewDurationValue("1h30m", time.Second), NewDurationValueFromUnits(5400, time.Second))
+	assert.Equal(t, NewDurationValue("1h", time.Second), NewDurationValueFromUnits(3600, time.Second))
+	assert.Equal(t, NewDurationValue("1h0m30s", time.Second), NewDurationValueFromUnits(3630, time.Second))
+	assert.Equal(t, NewDurationValue("1.5s", time.Millisecond), NewDurationValueFromUnits(1500, time.Millisecond))
+	assert.Equal(t, NewDurationValue("0s", time.Millisecond), NewDurationValueFromUnits(0, time.Millisecond))
+	assert.True(t, NewDurationPointerValueFromUnits(nil, time.Millisecond).IsNull())
+}
+
+func TestDurationValidateAttribute(t *testing.T) {
+	tests := []struct {
+		value     DurationValue
+		wantError bool
+	}{
+		{value: NewDurationValue("5m", time.Second)},
+		{value: NewDurationValue("300", time.Second)},
+		{value: NewDurationValue("250ms", time.Millisecond)},
+		{value: NewDurationNull(time.Second)},
+		{value: NewDurationUnknown(time.Second)},
+		{value: NewDurationValue("5 minutes", time.Second), wantError: true},
+		{value: NewDurationValue("-5m", time.Second), wantError: true},
+		{value: NewDurationValue("1500ms", time.Second), wantError: true},
+		{value: NewDurationValue("NaN", time.Second), wantError: true},
+	}
+
+	for _, tt := range tests {
+		resp := &xattr.ValidateAttributeResponse{}
+		tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("interval")}, resp)
+		assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "%s: %v", tt.value, resp.Diagnostics)
+	}
+}
+
+func TestDurationAtLeast(t *testing.T) {
+	tests := []struct {
+		value     types.String
+		wantError bool
+	}{
+		{value: types.StringValue("1m")},
+		{value: types.StringValue("90")},
+		{value: types.StringValue("30s"), wantError: true},
+		{value: types.StringValue("59"), wantError: true},
+		{value: types.StringNull()},
+		{value: types.StringValue("5 minutes")}, // Reported by ValidateAttribute
+	}
+
+	for _, tt := range tests {
+		resp := &validator.StringResponse{}
+		req := validator.StringRequest{Path: path.Root("interval"), ConfigValue: tt.value}
+		durationAtLeast(time.Second, time.Minute).ValidateString(context.Background(), req, resp)
+		assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "%s: %v", tt.value, resp.Diagnostics)
+	}
+}
@@ -1,12 +1,12 @@
 package provider
 
-import "strings"
+import (
+	"errors"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
 
 // isNotFoundError checks if an error indicates a resource was not found.
-// It performs a case-insensitive check for "not found" in the error message.
 func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(strings.ToLower(err.Error()), "not found")
+	return errors.Is(err, client.ErrNotFound)
 }
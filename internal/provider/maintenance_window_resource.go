@@ -0,0 +1,592 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+	"github.com/riccap/terraform-provider-uptrace/internal/schedule"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &MaintenanceWindowResource{}
+	_ resource.ResourceWithConfigure        = &MaintenanceWindowResource{}
+	_ resource.ResourceWithConfigValidators = &MaintenanceWindowResource{}
+	_ resource.ResourceWithValidateConfig   = &MaintenanceWindowResource{}
+	_ resource.ResourceWithModifyPlan       = &MaintenanceWindowResource{}
+)
+
+// maintenanceWindowFilterAttrTypes are the attribute types of monitor_filter.
+var maintenanceWindowFilterAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"name": types.StringType,
+}
+
+// NewMaintenanceWindowResource is a helper function to create the resource.
+func NewMaintenanceWindowResource() resource.Resource {
+	return &MaintenanceWindowResource{now: time.Now}
+}
+
+// MaintenanceWindowResource is the resource implementation.
+//
+// Uptrace has no maintenance windows, so the window only exists in Terraform state.
+// Whether a window is active is decided when Terraform plans and applies: the selected
+// monitors are paused through the API during an occurrence and activated after it, so
+// the window takes effect on the first apply after it starts or ends.
+type MaintenanceWindowResource struct {
+	client *client.Client
+	// now returns the current time. Tests replace it with a fake clock.
+	now func() time.Time
+}
+
+// MaintenanceWindowResourceModel describes the resource data model.
+type MaintenanceWindowResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectID        types.Int64  `tfsdk:"project_id"`
+	Name             types.String `tfsdk:"name"`
+	MonitorIDs       types.Set    `tfsdk:"monitor_ids"`
+	MonitorFilter    types.Object `tfsdk:"monitor_filter"`
+	StartTime        types.String `tfsdk:"start_time"`
+	EndTime          types.String `tfsdk:"end_time"`
+	Recurrence       types.String `tfsdk:"recurrence"`
+	Active           types.Bool   `tfsdk:"active"`
+	PausedMonitorIDs types.Set    `tfsdk:"paused_monitor_ids"`
+}
+
+// MaintenanceWindowFilterModel describes the monitor_filter attribute.
+type MaintenanceWindowFilterModel struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+// Metadata returns the resource type name.
+func (r *MaintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+// Schema defines the schema for the resource.
+func (r *MaintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Silences a set of Uptrace monitors on a schedule by pausing them during a maintenance window. " +
+			"The window is evaluated whenever Terraform plans and applies, so it takes effect on the first apply " +
+			"after an occurrence starts or ends. Don't set paused on the uptrace_monitor resources the window selects: " +
+			"both change the same monitor status, so paused = false activates the monitors during the window " +
+			"and the window's pause shows as a change to paused.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Maintenance window identifier, generated by the provider.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": projectIDResourceAttribute("maintenance window"),
+			"name": schema.StringAttribute{
+				Description: "Maintenance window name.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				Description: "IDs of the monitors to pause. Exactly one of monitor_ids or monitor_filter must be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"monitor_filter": schema.SingleNestedAttribute{
+				Description: "Selects the monitors to pause by type and name, as in the uptrace_monitors data source. " +
+					"An empty filter selects every monitor in the project. " +
+					"Exactly one of monitor_ids or monitor_filter must be set.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Select monitors by type (metric, error, span, log or uptime).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(monitorTypes...),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "Select monitors by name (case-insensitive substring match).",
+						Optional:    true,
+					},
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Start of the window as an RFC 3339 timestamp, such as \"2026-03-04T22:00:00Z\".",
+				Required:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "End of the window as an RFC 3339 timestamp. Must be after start_time.",
+				Required:    true,
+			},
+			"recurrence": schema.StringAttribute{
+				Description: "Cron expression (minute, hour, day of month, month, day of week) that repeats the window, " +
+					"such as \"0 22 * * 1-5\" or \"@weekly\". Every matching minute after start_time starts a window " +
+					"lasting as long as the first one. The expression is evaluated at the time zone offset of start_time.",
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the window was in effect at the last apply. Refreshing sets it to false when " +
+					"a monitor paused by the window was activated outside Terraform, so the next apply pauses it again.",
+				Computed: true,
+			},
+			"paused_monitor_ids": schema.SetAttribute{
+				Description: "IDs of the monitors paused by this window. Monitors that were already paused " +
+					"are left out, so they stay paused when the window ends.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the resource-level validators.
+func (r *MaintenanceWindowResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("monitor_ids"),
+			path.MatchRoot("monitor_filter"),
+		),
+	}
+}
+
+// ValidateConfig checks the start and end times and the recurrence.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOK := parseWindowTime(config.StartTime, path.Root("start_time"), &resp.Diagnostics)
+	end, endOK := parseWindowTime(config.EndTime, path.Root("end_time"), &resp.Diagnostics)
+	if startOK && endOK {
+		if err := (schedule.Window{Start: start, End: end}).Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid Maintenance Window",
+				fmt.Sprintf("The window is empty: %s.", err.Error()),
+			)
+		}
+	}
+
+	if !config.Recurrence.IsNull() && !config.Recurrence.IsUnknown() {
+		if _, err := schedule.ParseCron(config.Recurrence.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recurrence"),
+				"Invalid Recurrence",
+				fmt.Sprintf("Could not parse the cron expression: %s.", err.Error()),
+			)
+		}
+	}
+}
+
+// parseWindowTime parses an RFC 3339 timestamp. It returns false when the value is
+// null, unknown or invalid, adding an error for invalid values.
+func parseWindowTime(value types.String, attributePath path.Path, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as \"2026-03-04T22:00:00Z\", got %q.", value.ValueString()),
+		)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// maintenanceWindowSchedule returns the schedule of a window. It returns false while
+// any of its attributes is unknown or invalid.
+func maintenanceWindowSchedule(model *MaintenanceWindowResourceModel) (schedule.Window, bool) {
+	diags := diag.Diagnostics{}
+	start, startOK := parseWindowTime(model.StartTime, path.Root("start_time"), &diags)
+	end, endOK := parseWindowTime(model.EndTime, path.Root("end_time"), &diags)
+	if !startOK || !endOK || model.Recurrence.IsUnknown() {
+		return schedule.Window{}, false
+	}
+
+	window := schedule.Window{Start: start, End: end}
+	if !model.Recurrence.IsNull() {
+		recurrence, err := schedule.ParseCron(model.Recurrence.ValueString())
+		if err != nil {
+			return schedule.Window{}, false
+		}
+		window.Recurrence = recurrence
+	}
+	return window, window.Validate() == nil
+}
+
+// ModifyPlan plans an update when a window has started or ended since the last apply,
+// so the monitors are paused or activated even though the configuration is unchanged.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Creating and destroying the window already pause and activate the monitors
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, ok := maintenanceWindowSchedule(&plan)
+	if !ok || window.ActiveAt(r.now()) == state.Active.ValueBool() {
+		return
+	}
+
+	tflog.Debug(ctx, "Maintenance window changed activity since the last apply", map[string]any{
+		"id":     state.ID.ValueString(),
+		"active": !state.Active.ValueBool(),
+	})
+
+	// The window is evaluated again at apply time, which may be later
+	plan.Active = types.BoolUnknown()
+	plan.PausedMonitorIDs = types.SetUnknown(types.StringType)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MaintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = uptraceClient
+}
+
+// Create creates the resource and sets the initial Terraform state.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating maintenance window", map[string]any{"name": plan.Name.ValueString()})
+
+	id, err := newMaintenanceWindowID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Maintenance Window",
+			fmt.Sprintf("Could not generate an ID: %s", err.Error()),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+
+	// Save state even when some monitors could not be paused, so the paused ones are activated later
+	r.applyWindow(ctx, clientForProject(r.client, plan.ProjectID), &plan, types.SetNull(types.StringType), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Created maintenance window", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data. The window only exists in
+// Terraform state, so only the monitors it paused are refreshed: monitors that were
+// deleted or activated outside Terraform are no longer recorded as paused by the window.
+// When that happens during an active window, the window is marked inactive, so the next
+// plan updates it and the monitors are paused again.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading maintenance window", map[string]any{"id": state.ID.ValueString()})
+
+	if len(state.PausedMonitorIDs.Elements()) > 0 {
+		monitors, err := clientForProject(r.client, state.ProjectID).ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Maintenance Window",
+				fmt.Sprintf("Could not list monitors: %s", err.Error()),
+			)
+			return
+		}
+		refreshPausedMonitors(ctx, monitors, &state)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refreshPausedMonitors drops the monitors that are no longer paused from state.PausedMonitorIDs,
+// and marks an active window inactive when a monitor it paused was activated outside Terraform.
+func refreshPausedMonitors(ctx context.Context, monitors []generated.Monitor, state *MaintenanceWindowResourceModel) {
+	pausedByAPI := make(map[string]bool, len(monitors))
+	for i := range monitors {
+		pausedByAPI[strconv.FormatInt(monitors[i].Id, 10)] = monitors[i].State == generated.MonitorStatePaused
+	}
+
+	paused := make(map[string]bool)
+	var activated []string
+	for _, monitorID := range setStrings(state.PausedMonitorIDs) {
+		isPaused, found := pausedByAPI[monitorID]
+		switch {
+		case isPaused:
+			paused[monitorID] = true
+		case found:
+			activated = append(activated, monitorID)
+		}
+	}
+	state.PausedMonitorIDs = stringSet(paused)
+
+	if len(activated) > 0 && state.Active.ValueBool() {
+		tflog.Debug(ctx, "Monitors paused by the maintenance window were activated outside Terraform", map[string]any{
+			"id":       state.ID.ValueString(),
+			"monitors": activated,
+		})
+		state.Active = types.BoolValue(false)
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating maintenance window", map[string]any{"id": plan.ID.ValueString()})
+
+	r.applyWindow(ctx, clientForProject(r.client, plan.ProjectID), &plan, state.PausedMonitorIDs, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated maintenance window", map[string]any{"id": plan.ID.ValueString()})
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The monitors paused by the window are activated.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting maintenance window", map[string]any{"id": state.ID.ValueString()})
+
+	apiClient := clientForProject(r.client, state.ProjectID)
+	for _, monitorID := range setStrings(state.PausedMonitorIDs) {
+		err := apiClient.ActivateMonitor(ctx, monitorID)
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Maintenance Window",
+				fmt.Sprintf("Could not activate monitor ID %s: %s", monitorID, err.Error()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleted maintenance window", map[string]any{"id": state.ID.ValueString()})
+}
+
+// applyWindow pauses the selected monitors while the window is active and activates the
+// monitors it paused once the window is over or they are no longer selected. Monitors
+// that are already paused by someone else are left alone. It sets plan.Active and
+// plan.PausedMonitorIDs, also when some monitors could not be paused or activated.
+func (r *MaintenanceWindowResource) applyWindow(
+	ctx context.Context, apiClient *client.Client, plan *MaintenanceWindowResourceModel, prior types.Set, diags *diag.Diagnostics,
+) {
+	window, ok := maintenanceWindowSchedule(plan)
+	active := ok && window.ActiveAt(r.now())
+	plan.Active = types.BoolValue(active)
+
+	// Monitors stay recorded until they are activated
+	previous := make(map[string]bool)
+	paused := make(map[string]bool)
+	for _, monitorID := range setStrings(prior) {
+		previous[monitorID] = true
+		paused[monitorID] = true
+	}
+	defer func() {
+		plan.PausedMonitorIDs = stringSet(paused)
+	}()
+
+	monitors, err := apiClient.ListMonitors(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Applying Maintenance Window",
+			fmt.Sprintf("Could not list monitors: %s", err.Error()),
+		)
+		return
+	}
+
+	byID := make(map[string]*generated.Monitor, len(monitors))
+	for i := range monitors {
+		byID[strconv.FormatInt(monitors[i].Id, 10)] = &monitors[i]
+	}
+
+	selected := make(map[string]bool)
+	if active {
+		for _, monitorID := range selectWindowMonitors(ctx, monitors, plan, diags) {
+			selected[monitorID] = true
+		}
+	}
+
+	tflog.Debug(ctx, "Applying maintenance window", map[string]any{
+		"id":       plan.ID.ValueString(),
+		"active":   active,
+		"selected": len(selected),
+		"paused":   len(previous),
+	})
+
+	for _, monitorID := range sortedKeys(previous) {
+		if selected[monitorID] {
+			continue
+		}
+		if monitor, found := byID[monitorID]; found && monitor.State == generated.MonitorStatePaused {
+			if err := apiClient.ActivateMonitor(ctx, monitorID); err != nil && !isNotFoundError(err) {
+				diags.AddError(
+					"Error Applying Maintenance Window",
+					fmt.Sprintf("Could not activate monitor ID %s: %s", monitorID, err.Error()),
+				)
+				continue
+			}
+		}
+		delete(paused, monitorID)
+	}
+
+	for _, monitorID := range sortedKeys(selected) {
+		if byID[monitorID].State == generated.MonitorStatePaused {
+			continue
+		}
+		if err := apiClient.PauseMonitor(ctx, monitorID); err != nil {
+			diags.AddError(
+				"Error Applying Maintenance Window",
+				fmt.Sprintf("Could not pause monitor ID %s: %s", monitorID, err.Error()),
+			)
+			continue
+		}
+		paused[monitorID] = true
+	}
+}
+
+// selectWindowMonitors returns the IDs of the monitors selected by monitor_ids or monitor_filter.
+// Configured IDs that don't match a monitor are reported as a warning.
+func selectWindowMonitors(ctx context.Context, monitors []generated.Monitor, plan *MaintenanceWindowResourceModel, diags *diag.Diagnostics) []string {
+	var selected []string
+
+	if !plan.MonitorIDs.IsNull() {
+		existing := make(map[string]bool, len(monitors))
+		for i := range monitors {
+			existing[strconv.FormatInt(monitors[i].Id, 10)] = true
+		}
+		for _, monitorID := range setStrings(plan.MonitorIDs) {
+			if !existing[monitorID] {
+				diags.AddAttributeWarning(
+					path.Root("monitor_ids"),
+					"Monitor Not Found",
+					fmt.Sprintf("Monitor ID %s does not exist, so the maintenance window does not pause it.", monitorID),
+				)
+				continue
+			}
+			selected = append(selected, monitorID)
+		}
+		return selected
+	}
+
+	var filter MaintenanceWindowFilterModel
+	diags.Append(plan.MonitorFilter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+	for _, monitor := range filterMonitors(monitors, filter.Type, types.StringNull(), filter.Name) {
+		selected = append(selected, strconv.FormatInt(monitor.Id, 10))
+	}
+	return selected
+}
+
+// newMaintenanceWindowID returns a random identifier for a maintenance window.
+func newMaintenanceWindowID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// setStrings returns the elements of a string set in sorted order.
+func setStrings(set types.Set) []string {
+	values := make([]string, 0, len(set.Elements()))
+	for _, elem := range set.Elements() {
+		if s, ok := elem.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values = append(values, s.ValueString())
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// stringSet returns a string set holding the keys of values.
+func stringSet(values map[string]bool) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, value := range sortedKeys(values) {
+		elems = append(elems, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// sortedKeys returns the keys of a set in sorted order.
+func sortedKeys(values map[string]bool) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccMaintenanceWindowResource_Basic(t *testing.T) {
+	resourceName := "uptrace_maintenance_window.test"
+	monitorName := acceptancetests.RandomTestName("tf-acc-maintenance")
+	now := time.Now().UTC()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy,
+		Steps: []resource.TestStep{
+			// A window in progress pauses the monitor
+			{
+				Config: testAccMaintenanceWindowResourceConfig(monitorName, now.Add(-time.Hour), now.Add(time.Hour)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "paused_monitor_ids.#", "1"),
+					testAccCheckMonitorState("uptrace_monitor.test", "paused"),
+				),
+			},
+			// Moving the window to the future activates it again
+			{
+				Config: testAccMaintenanceWindowResourceConfig(monitorName, now.Add(24*time.Hour), now.Add(25*time.Hour)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "paused_monitor_ids.#", "0"),
+					testAccCheckMonitorState("uptrace_monitor.test", "open"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMonitorState(resourceName, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := acceptancetests.GetTestClient()
+		monitor, err := client.GetMonitor(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Monitor %s not found: %w", rs.Primary.ID, err)
+		}
+		if string(monitor.State) != state {
+			return fmt.Errorf("Monitor %s is %s, expected %s", rs.Primary.ID, monitor.State, state)
+		}
+		return nil
+	}
+}
+
+func testAccMaintenanceWindowResourceConfig(monitorName string, start, end time.Time) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_monitor" "test" {
+  name = "%s"
+  type = "error"
+
+  error_params = {
+    metrics = [
+      {
+        name  = "uptrace_tracing_events"
+        alias = "$logs"
+      }
+    ]
+    query = "sum($logs) | where span.event_name exists"
+  }
+}
+
+resource "uptrace_maintenance_window" "test" {
+  name        = "%s"
+  monitor_ids = [uptrace_monitor.test.id]
+  start_time  = "%s"
+  end_time    = "%s"
+}
+`, acceptancetests.GetTestProviderConfig(), monitorName, monitorName, start.Format(time.RFC3339), end.Format(time.RFC3339))
+}
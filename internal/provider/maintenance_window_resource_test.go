@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// fakeMonitorAPI serves the monitor list and the pause/activate endpoints of project 1.
+type fakeMonitorAPI struct {
+	mu       sync.Mutex
+	monitors []map[string]any
+	calls    []string
+}
+
+func newFakeMonitorAPI(t *testing.T, monitors ...map[string]any) (*fakeMonitorAPI, *client.Client) {
+	t.Helper()
+	api := &fakeMonitorAPI{monitors: monitors}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+	return api, c
+}
+
+func testMonitor(id int64, name, monitorType, state string) map[string]any {
+	return map[string]any{"id": id, "name": name, "type": monitorType, "state": state}
+}
+
+func (api *fakeMonitorAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodGet && r.URL.Path == "/projects/1/monitors" {
+		_ = json.NewEncoder(w).Encode(map[string]any{"monitors": api.monitors})
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/projects/1/monitors/"), "/")
+	if r.Method != http.MethodPut || len(parts) != 2 {
+		writeNotFound(w)
+		return
+	}
+	for _, monitor := range api.monitors {
+		if fmt.Sprint(monitor["id"]) != parts[0] {
+			continue
+		}
+		switch parts[1] {
+		case "paused":
+			monitor["state"] = "paused"
+			api.calls = append(api.calls, "pause "+parts[0])
+		case "active":
+			monitor["state"] = "open"
+			api.calls = append(api.calls, "activate "+parts[0])
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	writeNotFound(w)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"error": {"code": "not_found", "message": "Monitor not found"}, "statusCode": 404}`))
+}
+
+// state returns the current state of a monitor.
+func (api *fakeMonitorAPI) state(id int64) string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for _, monitor := range api.monitors {
+		if monitor["id"] == id {
+			return monitor["state"].(string)
+		}
+	}
+	return ""
+}
+
+func fakeClock(t *testing.T, value string) func() time.Time {
+	t.Helper()
+	now, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return func() time.Time { return now }
+}
+
+func testStringSet(values ...string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, value := range values {
+		elems[i] = types.StringValue(value)
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// testMaintenanceWindow returns a nightly window from 22:00 to 02:00 UTC selecting monitor_ids.
+func testMaintenanceWindow(monitorIDs ...string) MaintenanceWindowResourceModel {
+	return MaintenanceWindowResourceModel{
+		ID:               types.StringValue("abc"),
+		ProjectID:        types.Int64Value(1),
+		Name:             types.StringValue("Nightly deploys"),
+		MonitorIDs:       testStringSet(monitorIDs...),
+		MonitorFilter:    types.ObjectNull(maintenanceWindowFilterAttrTypes),
+		StartTime:        types.StringValue("2026-03-04T22:00:00Z"),
+		EndTime:          types.StringValue("2026-03-05T02:00:00Z"),
+		Recurrence:       types.StringValue("0 22 * * *"),
+		Active:           types.BoolValue(false),
+		PausedMonitorIDs: testStringSet(),
+	}
+}
+
+func TestMaintenanceWindowApply(t *testing.T) {
+	ctx := context.Background()
+	api, c := newFakeMonitorAPI(t,
+		testMonitor(1, "API latency", "metric", "firing"),
+		testMonitor(2, "API errors", "error", "open"),
+		testMonitor(3, "Disk usage", "metric", "paused"),
+		testMonitor(4, "Queue depth", "metric", "open"),
+	)
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-06T23:00:00Z")}
+
+	// Monitor 3 was paused before the window started, so the window leaves it out
+	plan := testMaintenanceWindow("1", "2", "3", "404")
+	diags := diag.Diagnostics{}
+	r.applyWindow(ctx, c, &plan, types.SetNull(types.StringType), &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Len(t, diags.Warnings(), 1)
+	assert.True(t, plan.Active.ValueBool())
+	assert.Equal(t, testStringSet("1", "2"), plan.PausedMonitorIDs)
+	assert.Equal(t, []string{"pause 1", "pause 2"}, api.calls)
+
+	// Monitors removed from the window are activated while it is still active
+	prior := plan.PausedMonitorIDs
+	plan = testMaintenanceWindow("1", "3", "4")
+	api.calls = nil
+	r.applyWindow(ctx, c, &plan, prior, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, testStringSet("1", "4"), plan.PausedMonitorIDs)
+	assert.Equal(t, []string{"activate 2", "pause 4"}, api.calls)
+
+	// Once the window is over, only the monitors it paused are activated
+	prior = plan.PausedMonitorIDs
+	r.now = fakeClock(t, "2026-03-07T02:00:00Z")
+	api.calls = nil
+	r.applyWindow(ctx, c, &plan, prior, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.False(t, plan.Active.ValueBool())
+	assert.Equal(t, testStringSet(), plan.PausedMonitorIDs)
+	assert.Equal(t, []string{"activate 1", "activate 4"}, api.calls)
+	assert.Equal(t, "paused", api.state(3))
+}
+
+func TestMaintenanceWindowApply_Filter(t *testing.T) {
+	ctx := context.Background()
+	api, c := newFakeMonitorAPI(t,
+		testMonitor(1, "API latency", "metric", "open"),
+		testMonitor(2, "API errors", "error", "open"),
+		testMonitor(3, "Disk usage", "metric", "open"),
+	)
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-04T23:00:00Z")}
+
+	plan := testMaintenanceWindow()
+	plan.MonitorIDs = types.SetNull(types.StringType)
+	plan.MonitorFilter = types.ObjectValueMust(maintenanceWindowFilterAttrTypes, map[string]attr.Value{
+		"type": types.StringValue("metric"),
+		"name": types.StringValue("api"),
+	})
+
+	diags := diag.Diagnostics{}
+	r.applyWindow(ctx, c, &plan, types.SetNull(types.StringType), &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, testStringSet("1"), plan.PausedMonitorIDs)
+	assert.Equal(t, []string{"pause 1"}, api.calls)
+}
+
+func TestMaintenanceWindowApply_Inactive(t *testing.T) {
+	ctx := context.Background()
+	api, c := newFakeMonitorAPI(t, testMonitor(1, "API latency", "metric", "open"))
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-04T21:59:00Z")}
+
+	plan := testMaintenanceWindow("1")
+	diags := diag.Diagnostics{}
+	r.applyWindow(ctx, c, &plan, types.SetNull(types.StringType), &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.False(t, plan.Active.ValueBool())
+	assert.Equal(t, testStringSet(), plan.PausedMonitorIDs)
+	assert.Empty(t, api.calls)
+}
+
+func TestMaintenanceWindowDelete(t *testing.T) {
+	ctx := context.Background()
+	api, c := newFakeMonitorAPI(t,
+		testMonitor(1, "API latency", "metric", "paused"),
+		testMonitor(2, "Disk usage", "metric", "paused"),
+	)
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-04T23:00:00Z")}
+
+	// Monitor 404 was deleted while the window was active
+	model := testMaintenanceWindow("1", "2")
+	model.Active = types.BoolValue(true)
+	model.PausedMonitorIDs = testStringSet("1", "404")
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: newMaintenanceWindowState(t, model)}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"activate 1"}, api.calls)
+	assert.Equal(t, "paused", api.state(2))
+}
+
+func TestMaintenanceWindowRead(t *testing.T) {
+	ctx := context.Background()
+	api, c := newFakeMonitorAPI(t,
+		testMonitor(1, "API latency", "metric", "paused"),
+		testMonitor(2, "API errors", "error", "open"),
+	)
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-04T23:00:00Z")}
+
+	// Monitor 2 was activated by hand and monitor 404 deleted during the window
+	model := testMaintenanceWindow("1", "2", "404")
+	model.Active = types.BoolValue(true)
+	model.PausedMonitorIDs = testStringSet("1", "2", "404")
+
+	resp := &resource.ReadResponse{State: newMaintenanceWindowState(t, model)}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state MaintenanceWindowResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, testStringSet("1"), state.PausedMonitorIDs)
+	assert.False(t, state.Active.ValueBool(), "The next plan should update the window")
+	assert.Empty(t, api.calls)
+
+	// The update pauses monitor 2 again
+	r.applyWindow(ctx, c, &state, state.PausedMonitorIDs, &resp.Diagnostics)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+	assert.True(t, state.Active.ValueBool())
+	assert.Equal(t, testStringSet("1", "2"), state.PausedMonitorIDs)
+	assert.Equal(t, []string{"pause 2"}, api.calls)
+}
+
+func TestMaintenanceWindowRead_Unchanged(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeMonitorAPI(t, testMonitor(1, "API latency", "metric", "paused"))
+	r := &MaintenanceWindowResource{client: c, now: fakeClock(t, "2026-03-04T23:00:00Z")}
+
+	model := testMaintenanceWindow("1")
+	model.Active = types.BoolValue(true)
+	model.PausedMonitorIDs = testStringSet("1")
+
+	resp := &resource.ReadResponse{State: newMaintenanceWindowState(t, model)}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state MaintenanceWindowResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, model, state)
+}
+
+func TestMaintenanceWindowModifyPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		now         string
+		active      bool
+		wantUnknown bool
+	}{
+		{name: "still inactive", now: "2026-03-05T12:00:00Z", active: false},
+		{name: "started", now: "2026-03-05T22:00:00Z", active: false, wantUnknown: true},
+		{name: "still active", now: "2026-03-05T23:00:00Z", active: true},
+		{name: "ended", now: "2026-03-06T02:00:00Z", active: true, wantUnknown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			model := testMaintenanceWindow("1")
+			model.Active = types.BoolValue(tt.active)
+			state := newMaintenanceWindowState(t, model)
+
+			r := &MaintenanceWindowResource{now: fakeClock(t, tt.now)}
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				State: state,
+				Plan:  tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+			var plan MaintenanceWindowResourceModel
+			require.False(t, resp.Plan.Get(ctx, &plan).HasError())
+			assert.Equal(t, tt.wantUnknown, plan.Active.IsUnknown())
+			assert.Equal(t, tt.wantUnknown, plan.PausedMonitorIDs.IsUnknown())
+		})
+	}
+}
+
+func TestMaintenanceWindowValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		startTime  string
+		endTime    string
+		recurrence types.String
+		wantError  string
+	}{
+		{name: "valid", startTime: "2026-03-04T22:00:00+02:00", endTime: "2026-03-05T02:00:00+02:00",
+			recurrence: types.StringValue("0 22 * * mon-fri")},
+		{name: "invalid start", startTime: "2026-03-04 22:00", endTime: "2026-03-05T02:00:00Z",
+			recurrence: types.StringNull(), wantError: "Invalid Timestamp"},
+		{name: "end before start", startTime: "2026-03-04T22:00:00Z", endTime: "2026-03-04T21:00:00Z",
+			recurrence: types.StringNull(), wantError: "Invalid Maintenance Window"},
+		{name: "invalid recurrence", startTime: "2026-03-04T22:00:00Z", endTime: "2026-03-05T02:00:00Z",
+			recurrence: types.StringValue("0 22 * *"), wantError: "Invalid Recurrence"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := testMaintenanceWindow("1")
+			model.StartTime = types.StringValue(tt.startTime)
+			model.EndTime = types.StringValue(tt.endTime)
+			model.Recurrence = tt.recurrence
+			state := newMaintenanceWindowState(t, model)
+
+			resp := &resource.ValidateConfigResponse{}
+			(&MaintenanceWindowResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+// newMaintenanceWindowState returns a state holding model.
+func newMaintenanceWindowState(t *testing.T, model MaintenanceWindowResourceModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&MaintenanceWindowResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), "Diagnostics: %v", diags)
+	return state
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &MonitorAlertsDataSource{}
+	_ datasource.DataSourceWithConfigure = &MonitorAlertsDataSource{}
+)
+
+var (
+	// alertStates are the alert states accepted by the states filter.
+	alertStates = []string{
+		string(generated.AlertStateOpen),
+		string(generated.AlertStateFiring),
+		string(generated.AlertStateClosed),
+	}
+
+	// defaultAlertStates are the states returned when the states filter is omitted.
+	defaultAlertStates = []generated.AlertState{generated.AlertStateOpen, generated.AlertStateFiring}
+
+	// monitorAlertAttrTypes are the attribute types of an alert in the alerts list.
+	monitorAlertAttrTypes = map[string]attr.Type{
+		"id":            types.StringType,
+		"monitor_id":    types.StringType,
+		"name":          types.StringType,
+		"type":          types.StringType,
+		"state":         types.StringType,
+		"attributes":    types.MapType{ElemType: types.StringType},
+		"first_seen_at": types.StringType,
+		"last_seen_at":  types.StringType,
+		"created_at":    types.StringType,
+		"updated_at":    types.StringType,
+	}
+)
+
+// NewMonitorAlertsDataSource is a helper function to create the data source.
+func NewMonitorAlertsDataSource() datasource.DataSource {
+	return &MonitorAlertsDataSource{}
+}
+
+// MonitorAlertsDataSource is the data source implementation.
+type MonitorAlertsDataSource struct {
+	client *client.Client
+}
+
+// MonitorAlertsDataSourceModel describes the data source data model.
+type MonitorAlertsDataSourceModel struct {
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	MonitorID   types.String `tfsdk:"monitor_id"`
+	States      types.List   `tfsdk:"states"`
+	FiringCount types.Int64  `tfsdk:"firing_count"`
+	Alerts      types.List   `tfsdk:"alerts"`
+}
+
+// MonitorAlertModel describes an individual alert in the list.
+type MonitorAlertModel struct {
+	ID          types.String `tfsdk:"id"`
+	MonitorID   types.String `tfsdk:"monitor_id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	State       types.String `tfsdk:"state"`
+	Attributes  types.Map    `tfsdk:"attributes"`
+	FirstSeenAt types.String `tfsdk:"first_seen_at"`
+	LastSeenAt  types.String `tfsdk:"last_seen_at"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *MonitorAlertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_alerts"
+}
+
+// Schema defines the schema for the data source.
+func (d *MonitorAlertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the alerts of a monitor or of the whole project. By default only open and firing alerts are returned, " +
+			"so the data source can gate a deployment on firing_count.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDDataSourceAttribute(),
+			"monitor_id": schema.StringAttribute{
+				Description: "Only return alerts created by this monitor. When omitted, alerts of every monitor are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a monitor ID"),
+				},
+			},
+			"states": schema.ListAttribute{
+				Description: "Only return alerts in these states (open, firing, closed). Defaults to open and firing.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(alertStates...)),
+				},
+			},
+			"firing_count": schema.Int64Attribute{
+				Description: "Number of returned alerts that are firing.",
+				Computed:    true,
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "List of alerts matching the filter criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Alert identifier.",
+							Computed:    true,
+						},
+						"monitor_id": schema.StringAttribute{
+							Description: "ID of the monitor that created the alert. Null for alerts not created by a monitor.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Alert name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Alert type, such as metric or error.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Alert state (open, firing, closed).",
+							Computed:    true,
+						},
+						"attributes": schema.MapAttribute{
+							Description: "Attributes of the alerting time series or error group, such as service_name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"first_seen_at": schema.StringAttribute{
+							Description: "When the alert condition was first observed (Unix milliseconds).",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "When the alert condition was last observed (Unix milliseconds).",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Alert creation timestamp (Unix milliseconds).",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Alert last update timestamp (Unix milliseconds).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *MonitorAlertsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = uptraceClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (d *MonitorAlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config MonitorAlertsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the API filters
+	var params generated.ListAlertsParams
+	if !config.MonitorID.IsNull() {
+		monitorID, err := strconv.ParseInt(config.MonitorID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Monitor ID",
+				fmt.Sprintf("Could not parse monitor ID %q: %s", config.MonitorID.ValueString(), err.Error()),
+			)
+			return
+		}
+		params.MonitorId = &monitorID
+	}
+
+	states := defaultAlertStates
+	if !config.States.IsNull() {
+		var values []string
+		resp.Diagnostics.Append(config.States.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		states = make([]generated.AlertState, len(values))
+		for i, value := range values {
+			states[i] = generated.AlertState(value)
+		}
+	}
+	params.State = &states
+
+	tflog.Info(ctx, "Reading monitor alerts data source", map[string]any{
+		"monitor_id": config.MonitorID.ValueString(),
+		"states":     states,
+	})
+
+	// Fetch alerts from API
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+	alerts, err := apiClient.ListAlerts(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Monitor Alerts",
+			fmt.Sprintf("Could not list alerts: %s", err.Error()),
+		)
+		return
+	}
+
+	// Convert to Terraform state
+	stateValues := make([]attr.Value, len(states))
+	for i, state := range states {
+		stateValues[i] = types.StringValue(string(state))
+	}
+	config.States = types.ListValueMust(types.StringType, stateValues)
+
+	var firing int64
+	for i := range alerts {
+		if alerts[i].State == generated.AlertStateFiring {
+			firing++
+		}
+	}
+	config.FiringCount = types.Int64Value(firing)
+
+	config.Alerts = convertAlertsToList(ctx, alerts, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Info(ctx, "Successfully read monitor alerts data source", map[string]any{
+		"count":  len(alerts),
+		"firing": firing,
+	})
+}
+
+// convertAlertsToList converts API alerts to the alerts list attribute.
+func convertAlertsToList(ctx context.Context, alerts []generated.Alert, diags *diag.Diagnostics) types.List {
+	models := make([]MonitorAlertModel, 0, len(alerts))
+	for i := range alerts {
+		alert := &alerts[i]
+
+		model := MonitorAlertModel{
+			ID:          types.StringValue(strconv.FormatInt(alert.Id, 10)),
+			MonitorID:   types.StringNull(),
+			Name:        types.StringValue(alert.Name),
+			Type:        types.StringValue(alert.Type),
+			State:       types.StringValue(string(alert.State)),
+			Attributes:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			FirstSeenAt: unixMillisOrNull(alert.FirstSeenAt),
+			LastSeenAt:  unixMillisOrNull(alert.LastSeenAt),
+			CreatedAt:   unixMillisOrNull(alert.CreatedAt),
+			UpdatedAt:   unixMillisOrNull(alert.UpdatedAt),
+		}
+		if alert.MonitorId != nil {
+			model.MonitorID = types.StringValue(strconv.FormatInt(*alert.MonitorId, 10))
+		}
+		if alert.Attrs != nil {
+			attributes, d := types.MapValueFrom(ctx, types.StringType, *alert.Attrs)
+			diags.Append(d...)
+			model.Attributes = attributes
+		}
+
+		models = append(models, model)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: monitorAlertAttrTypes}, models)
+	diags.Append(d...)
+	return list
+}
+
+// unixMillisOrNull formats a Unix millisecond timestamp, or returns null when it is absent.
+func unixMillisOrNull(value *float64) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(fmt.Sprintf("%.0f", *value))
+}
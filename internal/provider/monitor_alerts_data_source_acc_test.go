@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccMonitorAlertsDataSource_Monitor(t *testing.T) {
+	dataSourceName := "data.uptrace_monitor_alerts.test"
+	monitorName := acceptancetests.RandomTestName("tf-acc-ds-alerts")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorAlertsDataSourceConfig(monitorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// A monitor that was just created has no alerts yet
+					resource.TestCheckResourceAttr(dataSourceName, "alerts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "firing_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "states.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "states.0", "open"),
+					resource.TestCheckResourceAttr(dataSourceName, "states.1", "firing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMonitorAlertsDataSourceConfig(monitorName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_monitor" "test" {
+  name = "%s"
+  type = "error"
+
+  error_params = {
+    metrics = [
+      {
+        name  = "uptrace_tracing_events"
+        alias = "$logs"
+      }
+    ]
+    query = "sum($logs) | where span.event_name exists"
+  }
+}
+
+data "uptrace_monitor_alerts" "test" {
+  monitor_id = uptrace_monitor.test.id
+}
+`, acceptancetests.GetTestProviderConfig(), monitorName)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// readMonitorAlerts reads the data source with the given configuration from an API serving alerts.
+func readMonitorAlerts(t *testing.T, config MonitorAlertsDataSourceModel, handler http.HandlerFunc) MonitorAlertsDataSourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+
+	d := &MonitorAlertsDataSource{client: c}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// Build the configuration through a state, which can be set from a model
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, state.Set(ctx, &config).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+	var result MonitorAlertsDataSourceModel
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	return result
+}
+
+func testStringList(values ...string) types.List {
+	elems := make([]attr.Value, len(values))
+	for i, value := range values {
+		elems[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func testMonitorAlertsConfig() MonitorAlertsDataSourceModel {
+	return MonitorAlertsDataSourceModel{
+		ProjectID:   types.Int64Null(),
+		MonitorID:   types.StringNull(),
+		States:      types.ListNull(types.StringType),
+		FiringCount: types.Int64Null(),
+		Alerts:      types.ListNull(types.ObjectType{AttrTypes: monitorAlertAttrTypes}),
+	}
+}
+
+func TestMonitorAlertsDataSourceRead(t *testing.T) {
+	config := testMonitorAlertsConfig()
+	config.MonitorID = types.StringValue("42")
+
+	result := readMonitorAlerts(t, config, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/1/alerts", r.URL.Path)
+		assert.Equal(t, "42", r.URL.Query().Get("monitorId"))
+		assert.Equal(t, []string{"open", "firing"}, r.URL.Query()["state"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"alerts": [
+			{"id": 7, "projectId": 1, "monitorId": 42, "name": "avg($cpu) > 90", "type": "metric", "state": "firing",
+			 "attrs": {"host_name": "web-1"}, "firstSeenAt": 1767348994143.79, "lastSeenAt": 1767349594143,
+			 "createdAt": 1767348994143, "updatedAt": 1767349594143},
+			{"id": 8, "projectId": 1, "name": "connection refused", "type": "error", "state": "open"}
+		]}`))
+	})
+
+	assert.Equal(t, int64(1), result.ProjectID.ValueInt64())
+	assert.Equal(t, testStringList("open", "firing"), result.States)
+	assert.Equal(t, int64(1), result.FiringCount.ValueInt64())
+
+	var alerts []MonitorAlertModel
+	require.False(t, result.Alerts.ElementsAs(context.Background(), &alerts, false).HasError())
+	require.Len(t, alerts, 2)
+
+	assert.Equal(t, "7", alerts[0].ID.ValueString())
+	assert.Equal(t, "42", alerts[0].MonitorID.ValueString())
+	assert.Equal(t, "firing", alerts[0].State.ValueString())
+	assert.Equal(t, "web-1", alerts[0].Attributes.Elements()["host_name"].(types.String).ValueString())
+	assert.Equal(t, "1767348994144", alerts[0].FirstSeenAt.ValueString())
+	assert.Equal(t, "1767349594143", alerts[0].LastSeenAt.ValueString())
+
+	assert.True(t, alerts[1].MonitorID.IsNull())
+	assert.Empty(t, alerts[1].Attributes.Elements())
+	assert.True(t, alerts[1].FirstSeenAt.IsNull())
+}
+
+func TestMonitorAlertsDataSourceRead_States(t *testing.T) {
+	config := testMonitorAlertsConfig()
+	config.States = testStringList("closed")
+
+	result := readMonitorAlerts(t, config, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("monitorId"))
+		assert.Equal(t, []string{"closed"}, r.URL.Query()["state"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"alerts": [{"id": 9, "projectId": 1, "name": "avg($cpu) > 90", "type": "metric", "state": "closed"}]}`))
+	})
+
+	assert.Equal(t, testStringList("closed"), result.States)
+	assert.Equal(t, int64(0), result.FiringCount.ValueInt64())
+	assert.Len(t, result.Alerts.Elements(), 1)
+}
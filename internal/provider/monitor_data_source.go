@@ -43,18 +43,23 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "Monitor identifier.",
 				Required:    true,
 			},
+			"project_id": projectIDDataSourceAttribute(),
 			"name": schema.StringAttribute{
 				Description: "Monitor name.",
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Monitor type (metric or error).",
+				Description: "Monitor type (metric, error, span, log or uptime).",
 				Computed:    true,
 			},
 			"state": schema.StringAttribute{
 				Description: "Current monitor state (open, firing, paused).",
 				Computed:    true,
 			},
+			"paused": schema.BoolAttribute{
+				Description: "Whether the monitor is paused.",
+				Computed:    true,
+			},
 			"notify_everyone_by_email": schema.BoolAttribute{
 				Description: "Whether to notify all project members by email.",
 				Computed:    true,
@@ -77,8 +82,9 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 						Description: "Repeat interval strategy (default or custom).",
 						Computed:    true,
 					},
-					"interval": schema.Int64Attribute{
-						Description: "Custom interval in seconds (minimum 60).",
+					"interval": schema.StringAttribute{
+						Description: "Custom interval as a duration such as \"30m\".",
+						CustomType:  durationSecondsType,
 						Computed:    true,
 					},
 				},
@@ -112,6 +118,7 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 					"query": schema.StringAttribute{
 						Description: "UQL query for metric evaluation or error filtering.",
+						CustomType:  UQLQueryType{},
 						Computed:    true,
 					},
 					"column": schema.StringAttribute{
@@ -126,8 +133,77 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 						Description: "Maximum allowed value for the metric.",
 						Computed:    true,
 					},
-					"grouping_interval": schema.Float64Attribute{
-						Description: "Grouping interval in milliseconds.",
+					"grouping_interval": schema.StringAttribute{
+						Description: "Grouping interval as a duration such as \"5m\".",
+						CustomType:  durationMillisecondsType,
+						Computed:    true,
+					},
+					"check_num_point": schema.Int64Attribute{
+						Description: "Number of consecutive points that must breach threshold.",
+						Computed:    true,
+					},
+					"nulls_mode": schema.StringAttribute{
+						Description: "How to handle null values: allow, forbid, or convert.",
+						Computed:    true,
+					},
+					"time_offset": schema.StringAttribute{
+						Description: "Time offset as a duration such as \"5m\".",
+						CustomType:  durationMillisecondsType,
+						Computed:    true,
+					},
+				},
+			},
+			"metric_params": schema.SingleNestedAttribute{
+				Description: "Parameters of a metric monitor. Null for other monitor types.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"metrics": monitorMetricsDataSourceAttribute(),
+					"query": schema.StringAttribute{
+						Description: "UQL query for metric evaluation.",
+						CustomType:  UQLQueryType{},
+						Computed:    true,
+					},
+					"column": schema.StringAttribute{
+						Description: "Column of the query result to evaluate.",
+						Computed:    true,
+					},
+					"condition": schema.SingleNestedAttribute{
+						Description: "Alert condition equivalent to min_allowed_value and max_allowed_value. Null when neither is set.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								Description: "When the monitor alerts: above value, below value or outside lower and upper.",
+								Computed:    true,
+							},
+							"value": schema.Float64Attribute{
+								Description: "Threshold of the above and below operators.",
+								Computed:    true,
+							},
+							"lower": schema.Float64Attribute{
+								Description: "Lower bound of the outside operator.",
+								Computed:    true,
+							},
+							"upper": schema.Float64Attribute{
+								Description: "Upper bound of the outside operator.",
+								Computed:    true,
+							},
+							"for_points": schema.Int64Attribute{
+								Description: "Number of consecutive points that must meet the condition before the monitor alerts, equal to check_num_point.",
+								Computed:    true,
+							},
+						},
+					},
+					"min_allowed_value": schema.Float64Attribute{
+						Description: "Minimum allowed value for the metric.",
+						Computed:    true,
+					},
+					"max_allowed_value": schema.Float64Attribute{
+						Description: "Maximum allowed value for the metric.",
+						Computed:    true,
+					},
+					"grouping_interval": schema.StringAttribute{
+						Description: "Grouping interval as a duration such as \"5m\".",
+						CustomType:  durationMillisecondsType,
 						Computed:    true,
 					},
 					"check_num_point": schema.Int64Attribute{
@@ -138,8 +214,64 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 						Description: "How to handle null values: allow, forbid, or convert.",
 						Computed:    true,
 					},
-					"time_offset": schema.Float64Attribute{
-						Description: "Time offset in milliseconds.",
+					"time_offset": schema.StringAttribute{
+						Description: "Time offset as a duration such as \"5m\".",
+						CustomType:  durationMillisecondsType,
+						Computed:    true,
+					},
+				},
+			},
+			"error_params": schema.SingleNestedAttribute{
+				Description: "Parameters of an error monitor. Null for other monitor types.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"metrics": monitorMetricsDataSourceAttribute(),
+					"query": schema.StringAttribute{
+						Description: "UQL filter for errors.",
+						CustomType:  UQLQueryType{},
+						Computed:    true,
+					},
+				},
+			},
+			"span_params": monitorEventParamsDataSourceAttribute("span", "spans"),
+			"log_params":  monitorEventParamsDataSourceAttribute("log", "logs"),
+			"uptime_params": schema.SingleNestedAttribute{
+				Description: "Parameters of an uptime monitor. Null for other monitor types.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL to check.",
+						Computed:    true,
+					},
+					"method": schema.StringAttribute{
+						Description: "HTTP request method.",
+						Computed:    true,
+					},
+					"headers": schema.MapAttribute{
+						Description: "HTTP request headers.",
+						ElementType: types.StringType,
+						Computed:    true,
+						Sensitive:   true,
+					},
+					"body": schema.StringAttribute{
+						Description: "HTTP request body.",
+						Computed:    true,
+					},
+					"expected_status_codes": schema.ListAttribute{
+						Description: "Status codes of a successful check.",
+						ElementType: types.Int64Type,
+						Computed:    true,
+					},
+					"check_interval": schema.Int64Attribute{
+						Description: "Interval between checks in seconds.",
+						Computed:    true,
+					},
+					"timeout": schema.Int64Attribute{
+						Description: "Request timeout in seconds.",
+						Computed:    true,
+					},
+					"follow_redirects": schema.BoolAttribute{
+						Description: "Whether to follow redirects.",
 						Computed:    true,
 					},
 				},
@@ -156,6 +288,62 @@ func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	}
 }
 
+// monitorMetricsDataSourceAttribute returns the schema of the computed metrics list of metric_params and error_params.
+func monitorMetricsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "List of metrics to monitor.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Metric name.",
+					Computed:    true,
+				},
+				"alias": schema.StringAttribute{
+					Description: "Optional alias for the metric.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// monitorEventParamsDataSourceAttribute returns the schema of the computed span_params and log_params.
+func monitorEventParamsDataSourceAttribute(monitorType, events string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Parameters of a %s monitor. Null for other monitor types.", monitorType),
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: fmt.Sprintf("UQL query aggregating the matching %s.", events),
+				CustomType:  UQLQueryType{},
+				Computed:    true,
+			},
+			"column": schema.StringAttribute{
+				Description: "Column of the query result to evaluate.",
+				Computed:    true,
+			},
+			"min_allowed_value": schema.Float64Attribute{
+				Description: "Minimum allowed value for the column.",
+				Computed:    true,
+			},
+			"max_allowed_value": schema.Float64Attribute{
+				Description: "Maximum allowed value for the column.",
+				Computed:    true,
+			},
+			"grouping_interval": schema.StringAttribute{
+				Description: "Grouping interval as a duration such as \"5m\".",
+				CustomType:  durationMillisecondsType,
+				Computed:    true,
+			},
+			"check_num_point": schema.Int64Attribute{
+				Description: "Number of consecutive points that must breach threshold.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *MonitorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -187,7 +375,9 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	tflog.Info(ctx, "Reading monitor data source", map[string]any{"id": config.ID.ValueString()})
 
 	// Get monitor from API
-	monitor, err := d.client.GetMonitor(ctx, config.ID.ValueString())
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+	monitor, err := apiClient.GetMonitor(ctx, config.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Monitor",
@@ -27,8 +27,8 @@ func TestAccMonitorDataSource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "state", resourceName, "state"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.metrics.0.name", resourceName, "params.metrics.0.name"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.query", resourceName, "params.query"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.metrics.0.name", resourceName, "metric_params.metrics.0.name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.query", resourceName, "metric_params.query"),
 
 					// Verify computed fields are set
 					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
@@ -60,13 +60,13 @@ func TestAccMonitorDataSource_AllFields(t *testing.T) {
 					// Verify notification settings
 					resource.TestCheckResourceAttrPair(dataSourceName, "notify_everyone_by_email", resourceName, "notify_everyone_by_email"),
 
-					// Verify params
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.metrics.#", resourceName, "params.metrics.#"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.metrics.0.name", resourceName, "params.metrics.0.name"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.metrics.0.alias", resourceName, "params.metrics.0.alias"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.query", resourceName, "params.query"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.max_allowed_value", resourceName, "params.max_allowed_value"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "params.check_num_point", resourceName, "params.check_num_point"),
+					// Verify metric params
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.metrics.#", resourceName, "metric_params.metrics.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.metrics.0.name", resourceName, "metric_params.metrics.0.name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.metrics.0.alias", resourceName, "metric_params.metrics.0.alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.query", resourceName, "metric_params.query"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.max_allowed_value", resourceName, "metric_params.max_allowed_value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metric_params.check_num_point", resourceName, "metric_params.check_num_point"),
 
 					// Verify repeat interval
 					resource.TestCheckResourceAttrPair(dataSourceName, "repeat_interval.strategy", resourceName, "repeat_interval.strategy"),
@@ -102,12 +102,13 @@ resource "uptrace_monitor" "test" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 80"
+    column            = "avg($cpu)"
     max_allowed_value = 80
   }
 }
@@ -132,12 +133,13 @@ resource "uptrace_monitor" "test" {
     strategy = "default"
   }
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 90"
+    column            = "avg($cpu)"
     max_allowed_value = 90
     check_num_point   = 3
   }
@@ -3,32 +3,208 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
 	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
 )
 
+// monitorParamDefaults lists params Uptrace fills in when they are omitted.
+// A param holding its default is kept out of the state unless the prior state sets it,
+// so imported monitors generate minimal configuration.
+var monitorParamDefaults = map[string]attr.Value{
+	"check_num_point":   types.Int64Value(client.DefaultCheckNumPoint),
+	"nulls_mode":        types.StringValue(string(client.DefaultNullsMode)),
+	"grouping_interval": NewDurationValueFromUnits(client.DefaultGroupingInterval, time.Millisecond),
+	"time_offset":       NewDurationValueFromUnits(client.DefaultTimeOffset, time.Millisecond),
+}
+
+// uptimeParamDefaults lists the uptime check settings Uptrace uses when they are omitted.
+// They are kept out of the state like monitorParamDefaults.
+var uptimeParamDefaults = map[string]attr.Value{
+	"method":                types.StringValue(string(client.DefaultUptimeMethod)),
+	"expected_status_codes": types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(client.DefaultUptimeStatusCode)}),
+	"check_interval":        types.Int64Value(client.DefaultUptimeCheckInterval),
+	"timeout":               types.Int64Value(client.DefaultUptimeTimeout),
+	"follow_redirects":      types.BoolValue(client.DefaultUptimeFollowRedirects),
+}
+
+// metricDefinitionAttrTypes are the attribute types of a metric definition.
+var metricDefinitionAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"alias": types.StringType,
+}
+
+// monitorParamsAttrTypes are the attribute types of the deprecated params object.
+var monitorParamsAttrTypes = map[string]attr.Type{
+	"metrics":           types.ListType{ElemType: types.ObjectType{AttrTypes: metricDefinitionAttrTypes}},
+	"query":             UQLQueryType{},
+	"column":            types.StringType,
+	"min_allowed_value": types.Float64Type,
+	"max_allowed_value": types.Float64Type,
+	"grouping_interval": durationMillisecondsType,
+	"check_num_point":   types.Int64Type,
+	"nulls_mode":        types.StringType,
+	"time_offset":       durationMillisecondsType,
+}
+
+// metricConditionAttrTypes are the attribute types of the metric_params condition object.
+var metricConditionAttrTypes = map[string]attr.Type{
+	"operator":   types.StringType,
+	"value":      types.Float64Type,
+	"lower":      types.Float64Type,
+	"upper":      types.Float64Type,
+	"for_points": types.Int64Type,
+}
+
+// metricParamsAttrTypes are the attribute types of the metric_params object:
+// the attributes of the deprecated params object and the condition object.
+var metricParamsAttrTypes = map[string]attr.Type{
+	"metrics":           types.ListType{ElemType: types.ObjectType{AttrTypes: metricDefinitionAttrTypes}},
+	"query":             UQLQueryType{},
+	"column":            types.StringType,
+	"condition":         types.ObjectType{AttrTypes: metricConditionAttrTypes},
+	"min_allowed_value": types.Float64Type,
+	"max_allowed_value": types.Float64Type,
+	"grouping_interval": durationMillisecondsType,
+	"check_num_point":   types.Int64Type,
+	"nulls_mode":        types.StringType,
+	"time_offset":       durationMillisecondsType,
+}
+
+// Operators of a metric monitor condition. Uptrace alerts when a value leaves the allowed range,
+// so each operator maps to min_allowed_value, max_allowed_value or both.
+const (
+	metricConditionAbove   = "above"
+	metricConditionBelow   = "below"
+	metricConditionOutside = "outside"
+)
+
+// metricConditionOperators are the operators accepted by the condition object.
+var metricConditionOperators = []string{metricConditionAbove, metricConditionBelow, metricConditionOutside}
+
+// errorParamsAttrTypes are the attribute types of the error_params object.
+var errorParamsAttrTypes = map[string]attr.Type{
+	"metrics": types.ListType{ElemType: types.ObjectType{AttrTypes: metricDefinitionAttrTypes}},
+	"query":   UQLQueryType{},
+}
+
+// spanParamsAttrTypes are the attribute types of the span_params object.
+var spanParamsAttrTypes = map[string]attr.Type{
+	"query":             UQLQueryType{},
+	"column":            types.StringType,
+	"min_allowed_value": types.Float64Type,
+	"max_allowed_value": types.Float64Type,
+	"grouping_interval": durationMillisecondsType,
+	"check_num_point":   types.Int64Type,
+}
+
+// logParamsAttrTypes are the attribute types of the log_params object, which has the same attributes as span_params.
+var logParamsAttrTypes = spanParamsAttrTypes
+
+// uptimeParamsAttrTypes are the attribute types of the uptime_params object.
+var uptimeParamsAttrTypes = map[string]attr.Type{
+	"url":                   types.StringType,
+	"method":                types.StringType,
+	"headers":               types.MapType{ElemType: types.StringType},
+	"body":                  types.StringType,
+	"expected_status_codes": types.ListType{ElemType: types.Int64Type},
+	"check_interval":        types.Int64Type,
+	"timeout":               types.Int64Type,
+	"follow_redirects":      types.BoolType,
+}
+
+// repeatIntervalAttrTypes are the attribute types of the repeat_interval object.
+var repeatIntervalAttrTypes = map[string]attr.Type{
+	"strategy": types.StringType,
+	"interval": durationSecondsType,
+}
+
 // RepeatIntervalModel represents the repeat interval configuration.
 type RepeatIntervalModel struct {
-	Strategy types.String `tfsdk:"strategy"`
-	Interval types.Int64  `tfsdk:"interval"`
+	Strategy types.String  `tfsdk:"strategy"`
+	Interval DurationValue `tfsdk:"interval"`
 }
 
-// MonitorParamsModel represents monitor parameters.
+// MonitorParamsModel represents the deprecated params attribute, which mixes metric and error parameters.
 type MonitorParamsModel struct {
 	Metrics          types.List    `tfsdk:"metrics"`
-	Query            types.String  `tfsdk:"query"`
+	Query            UQLQueryValue `tfsdk:"query"`
+	Column           types.String  `tfsdk:"column"`
+	MinAllowedValue  types.Float64 `tfsdk:"min_allowed_value"`
+	MaxAllowedValue  types.Float64 `tfsdk:"max_allowed_value"`
+	GroupingInterval DurationValue `tfsdk:"grouping_interval"`
+	CheckNumPoint    types.Int64   `tfsdk:"check_num_point"`
+	NullsMode        types.String  `tfsdk:"nulls_mode"`
+	TimeOffset       DurationValue `tfsdk:"time_offset"`
+}
+
+// MetricMonitorParamsModel represents the metric_params attribute. It maps to generated.MetricMonitorParams,
+// with the condition translated to the allowed value range.
+type MetricMonitorParamsModel struct {
+	Metrics          types.List    `tfsdk:"metrics"`
+	Query            UQLQueryValue `tfsdk:"query"`
 	Column           types.String  `tfsdk:"column"`
+	Condition        types.Object  `tfsdk:"condition"`
 	MinAllowedValue  types.Float64 `tfsdk:"min_allowed_value"`
 	MaxAllowedValue  types.Float64 `tfsdk:"max_allowed_value"`
-	GroupingInterval types.Float64 `tfsdk:"grouping_interval"`
+	GroupingInterval DurationValue `tfsdk:"grouping_interval"`
 	CheckNumPoint    types.Int64   `tfsdk:"check_num_point"`
 	NullsMode        types.String  `tfsdk:"nulls_mode"`
-	TimeOffset       types.Float64 `tfsdk:"time_offset"`
+	TimeOffset       DurationValue `tfsdk:"time_offset"`
+}
+
+// MetricConditionModel represents the condition attribute of metric_params.
+// ForPoints maps to check_num_point.
+type MetricConditionModel struct {
+	Operator  types.String  `tfsdk:"operator"`
+	Value     types.Float64 `tfsdk:"value"`
+	Lower     types.Float64 `tfsdk:"lower"`
+	Upper     types.Float64 `tfsdk:"upper"`
+	ForPoints types.Int64   `tfsdk:"for_points"`
+}
+
+// ErrorMonitorParamsModel represents the error_params attribute. It maps 1:1 to generated.ErrorMonitorParams.
+type ErrorMonitorParamsModel struct {
+	Metrics types.List    `tfsdk:"metrics"`
+	Query   UQLQueryValue `tfsdk:"query"`
+}
+
+// SpanMonitorParamsModel represents the span_params attribute. It maps 1:1 to generated.SpanMonitorParams.
+type SpanMonitorParamsModel struct {
+	Query            UQLQueryValue `tfsdk:"query"`
+	Column           types.String  `tfsdk:"column"`
+	MinAllowedValue  types.Float64 `tfsdk:"min_allowed_value"`
+	MaxAllowedValue  types.Float64 `tfsdk:"max_allowed_value"`
+	GroupingInterval DurationValue `tfsdk:"grouping_interval"`
+	CheckNumPoint    types.Int64   `tfsdk:"check_num_point"`
+}
+
+// LogMonitorParamsModel represents the log_params attribute. It maps 1:1 to generated.LogMonitorParams.
+type LogMonitorParamsModel struct {
+	Query            UQLQueryValue `tfsdk:"query"`
+	Column           types.String  `tfsdk:"column"`
+	MinAllowedValue  types.Float64 `tfsdk:"min_allowed_value"`
+	MaxAllowedValue  types.Float64 `tfsdk:"max_allowed_value"`
+	GroupingInterval DurationValue `tfsdk:"grouping_interval"`
+	CheckNumPoint    types.Int64   `tfsdk:"check_num_point"`
+}
+
+// UptimeMonitorParamsModel represents the uptime_params attribute. It maps 1:1 to generated.UptimeMonitorParams.
+type UptimeMonitorParamsModel struct {
+	URL                 types.String `tfsdk:"url"`
+	Method              types.String `tfsdk:"method"`
+	Headers             types.Map    `tfsdk:"headers"`
+	Body                types.String `tfsdk:"body"`
+	ExpectedStatusCodes types.List   `tfsdk:"expected_status_codes"`
+	CheckInterval       types.Int64  `tfsdk:"check_interval"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+	FollowRedirects     types.Bool   `tfsdk:"follow_redirects"`
 }
 
 // MetricDefinitionModel represents a metric definition.
@@ -56,13 +232,28 @@ func convertRepeatInterval(ctx context.Context, plan MonitorResourceModel, diags
 		strategy := generated.RepeatIntervalStrategy(repeatInterval.Strategy.ValueString())
 		ri.Strategy = &strategy
 	}
-	if !repeatInterval.Interval.IsNull() {
-		interval := repeatInterval.Interval.ValueInt64()
+	if seconds := durationUnits(repeatInterval.Interval, diags); seconds != nil {
+		interval := int64(*seconds)
 		ri.Interval = &interval
 	}
 	return &ri
 }
 
+// durationUnits converts a duration to the number of units Uptrace stores.
+// Null and unknown durations are omitted.
+func durationUnits(value DurationValue, diags *diag.Diagnostics) *float64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	units, err := value.ValueUnits()
+	if err != nil {
+		diags.AddError("Invalid Duration", fmt.Sprintf("Could not parse duration %q: %s", value.ValueString(), err.Error()))
+		return nil
+	}
+	return &units
+}
+
 // convertIDList converts a Terraform list of IDs to a slice of int64.
 func convertIDList(ctx context.Context, list types.List, diags *diag.Diagnostics) *[]int64 {
 	if list.IsNull() || list.IsUnknown() {
@@ -105,32 +296,83 @@ func planToMonitorInput(ctx context.Context, plan MonitorResourceModel, diags *d
 		input.TrendAggFunc = &trendAggFunc
 	}
 
-	// Convert params based on monitor type
-	if !plan.Params.IsNull() && !plan.Params.IsUnknown() {
-		var params MonitorParamsModel
-		diags.Append(plan.Params.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+	// Convert params from the configured block
+	switch {
+	case isKnownObject(plan.MetricParams):
+		var params MetricMonitorParamsModel
+		diags.Append(plan.MetricParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
 		if !diags.HasError() {
-			if plan.Type.ValueString() == "metric" {
-				convertToMetricParams(ctx, params, &input.Params, diags)
-			} else if plan.Type.ValueString() == "error" {
-				convertToErrorParams(ctx, params, &input.Params, diags)
-			}
+			convertToMetricParams(ctx, params, &input.Params, diags)
 		}
+	case isKnownObject(plan.ErrorParams):
+		var params ErrorMonitorParamsModel
+		diags.Append(plan.ErrorParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			convertToErrorParams(ctx, params, &input.Params, diags)
+		}
+	case isKnownObject(plan.SpanParams):
+		var params SpanMonitorParamsModel
+		diags.Append(plan.SpanParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			convertToSpanParams(params, &input.Params, diags)
+		}
+	case isKnownObject(plan.LogParams):
+		var params LogMonitorParamsModel
+		diags.Append(plan.LogParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			convertToLogParams(params, &input.Params, diags)
+		}
+	case isKnownObject(plan.UptimeParams):
+		var params UptimeMonitorParamsModel
+		diags.Append(plan.UptimeParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			convertToUptimeParams(ctx, params, &input.Params, diags)
+		}
+	case isKnownObject(plan.Params):
+		convertLegacyParams(ctx, plan, &input.Params, diags)
 	}
 
 	return input
 }
 
-// convertMetricsToAPI converts Terraform metric definitions to API format.
+// convertLegacyParams converts the deprecated params attribute based on the monitor type.
+// Params that don't apply to the monitor type are ignored.
 //
-//nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
-func convertMetricsToAPI(ctx context.Context, params MonitorParamsModel, diags *diag.Diagnostics) []generated.MetricDefinition {
-	if params.Metrics.IsNull() || params.Metrics.IsUnknown() {
+//nolint:gocritic // Plan passed by value to keep function signatures consistent
+func convertLegacyParams(ctx context.Context, plan MonitorResourceModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+	var params MonitorParamsModel
+	diags.Append(plan.Params.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	switch plan.Type.ValueString() {
+	case string(generated.MonitorTypeMetric):
+		convertToMetricParams(ctx, MetricMonitorParamsModel{
+			Metrics:          params.Metrics,
+			Query:            params.Query,
+			Column:           params.Column,
+			Condition:        types.ObjectNull(metricConditionAttrTypes),
+			MinAllowedValue:  params.MinAllowedValue,
+			MaxAllowedValue:  params.MaxAllowedValue,
+			GroupingInterval: params.GroupingInterval,
+			CheckNumPoint:    params.CheckNumPoint,
+			NullsMode:        params.NullsMode,
+			TimeOffset:       params.TimeOffset,
+		}, result, diags)
+	case string(generated.MonitorTypeError):
+		convertToErrorParams(ctx, ErrorMonitorParamsModel{Metrics: params.Metrics, Query: params.Query}, result, diags)
+	}
+}
+
+// convertMetricsToAPI converts Terraform metric definitions to API format.
+func convertMetricsToAPI(ctx context.Context, list types.List, diags *diag.Diagnostics) []generated.MetricDefinition {
+	if list.IsNull() || list.IsUnknown() {
 		return nil
 	}
 
 	var metrics []MetricDefinitionModel
-	diags.Append(params.Metrics.ElementsAs(ctx, &metrics, false)...)
+	diags.Append(list.ElementsAs(ctx, &metrics, false)...)
 	if diags.HasError() {
 		return nil
 	}
@@ -148,14 +390,14 @@ func convertMetricsToAPI(ctx context.Context, params MonitorParamsModel, diags *
 	return apiMetrics
 }
 
-// convertToMetricParams converts params to MetricMonitorParams.
+// convertToMetricParams converts metric params to MetricMonitorParams.
 //
 //nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
-func convertToMetricParams(ctx context.Context, params MonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+func convertToMetricParams(ctx context.Context, params MetricMonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
 	metricParams := generated.MetricMonitorParams{}
 
 	// Convert metrics
-	if apiMetrics := convertMetricsToAPI(ctx, params, diags); apiMetrics != nil {
+	if apiMetrics := convertMetricsToAPI(ctx, params.Metrics, diags); apiMetrics != nil {
 		metricParams.Metrics = apiMetrics
 	}
 
@@ -177,39 +419,94 @@ func convertToMetricParams(ctx context.Context, params MonitorParamsModel, resul
 		metricParams.MaxAllowedValue = &val
 	}
 
-	if !params.GroupingInterval.IsNull() {
-		val := params.GroupingInterval.ValueFloat64()
-		metricParams.GroupingInterval = &val
-	}
+	metricParams.GroupingInterval = durationUnits(params.GroupingInterval, diags)
 
 	if !params.CheckNumPoint.IsNull() {
 		val := int(params.CheckNumPoint.ValueInt64())
 		metricParams.CheckNumPoint = &val
 	}
 
+	// The condition replaces min_allowed_value, max_allowed_value and, with for_points,
+	// check_num_point, which conflict with it
+	if isKnownObject(params.Condition) {
+		var condition MetricConditionModel
+		diags.Append(params.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+		metricParams.MinAllowedValue, metricParams.MaxAllowedValue = conditionToAllowedRange(condition)
+		if !condition.ForPoints.IsNull() && !condition.ForPoints.IsUnknown() {
+			val := int(condition.ForPoints.ValueInt64())
+			metricParams.CheckNumPoint = &val
+		}
+	}
+
 	if !params.NullsMode.IsNull() {
 		mode := generated.MetricMonitorParamsNullsMode(params.NullsMode.ValueString())
 		metricParams.NullsMode = &mode
 	}
 
-	if !params.TimeOffset.IsNull() {
-		val := params.TimeOffset.ValueFloat64()
-		metricParams.TimeOffset = &val
-	}
+	metricParams.TimeOffset = durationUnits(params.TimeOffset, diags)
 
 	if err := result.FromMetricMonitorParams(metricParams); err != nil {
 		diags.AddError("Failed to convert metric params", err.Error())
 	}
 }
 
-// convertToErrorParams converts params to ErrorMonitorParams.
+// conditionToAllowedRange translates a condition to the allowed value range Uptrace alerts outside of.
+//
+//nolint:gocritic // Condition passed by value like the other params models
+func conditionToAllowedRange(condition MetricConditionModel) (minValue, maxValue *float64) {
+	switch condition.Operator.ValueString() {
+	case metricConditionAbove:
+		return nil, condition.Value.ValueFloat64Pointer()
+	case metricConditionBelow:
+		return condition.Value.ValueFloat64Pointer(), nil
+	case metricConditionOutside:
+		return condition.Lower.ValueFloat64Pointer(), condition.Upper.ValueFloat64Pointer()
+	}
+	return nil, nil
+}
+
+// allowedRangeToCondition translates an allowed value range to the condition alerting outside of it,
+// with checkNumPoint as its for_points. The condition is null when the range has no bounds.
+func allowedRangeToCondition(minValue, maxValue *float64, checkNumPoint *int) types.Object {
+	attrs := map[string]attr.Value{
+		"operator":   types.StringNull(),
+		"value":      types.Float64Null(),
+		"lower":      types.Float64Null(),
+		"upper":      types.Float64Null(),
+		"for_points": types.Int64Null(),
+	}
+	if checkNumPoint != nil {
+		attrs["for_points"] = types.Int64Value(int64(*checkNumPoint))
+	}
+
+	switch {
+	case minValue != nil && maxValue != nil:
+		attrs["operator"] = types.StringValue(metricConditionOutside)
+		attrs["lower"] = types.Float64Value(*minValue)
+		attrs["upper"] = types.Float64Value(*maxValue)
+	case maxValue != nil:
+		attrs["operator"] = types.StringValue(metricConditionAbove)
+		attrs["value"] = types.Float64Value(*maxValue)
+	case minValue != nil:
+		attrs["operator"] = types.StringValue(metricConditionBelow)
+		attrs["value"] = types.Float64Value(*minValue)
+	default:
+		return types.ObjectNull(metricConditionAttrTypes)
+	}
+	return types.ObjectValueMust(metricConditionAttrTypes, attrs)
+}
+
+// convertToErrorParams converts error params to ErrorMonitorParams.
 //
 //nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
-func convertToErrorParams(ctx context.Context, params MonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+func convertToErrorParams(ctx context.Context, params ErrorMonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
 	errorParams := generated.ErrorMonitorParams{}
 
 	// Convert metrics
-	if apiMetrics := convertMetricsToAPI(ctx, params, diags); apiMetrics != nil {
+	if apiMetrics := convertMetricsToAPI(ctx, params.Metrics, diags); apiMetrics != nil {
 		errorParams.Metrics = apiMetrics
 	}
 
@@ -223,12 +520,115 @@ func convertToErrorParams(ctx context.Context, params MonitorParamsModel, result
 	}
 }
 
+// convertToSpanParams converts span params to SpanMonitorParams.
+//
+//nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
+func convertToSpanParams(params SpanMonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+	if err := result.FromSpanMonitorParams(eventMonitorParams(params, diags)); err != nil {
+		diags.AddError("Failed to convert span params", err.Error())
+	}
+}
+
+// convertToLogParams converts log params to LogMonitorParams.
+//
+//nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
+func convertToLogParams(params LogMonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+	// Log monitors take the same params as span monitors.
+	logParams := generated.LogMonitorParams(eventMonitorParams(SpanMonitorParamsModel(params), diags))
+	if err := result.FromLogMonitorParams(logParams); err != nil {
+		diags.AddError("Failed to convert log params", err.Error())
+	}
+}
+
+// eventMonitorParams converts the params of span and log monitors, which evaluate a query over events.
+//
+//nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
+func eventMonitorParams(params SpanMonitorParamsModel, diags *diag.Diagnostics) generated.SpanMonitorParams {
+	eventParams := generated.SpanMonitorParams{
+		Query:  params.Query.ValueString(),
+		Column: params.Column.ValueString(),
+	}
+
+	if !params.MinAllowedValue.IsNull() {
+		val := params.MinAllowedValue.ValueFloat64()
+		eventParams.MinAllowedValue = &val
+	}
+
+	if !params.MaxAllowedValue.IsNull() {
+		val := params.MaxAllowedValue.ValueFloat64()
+		eventParams.MaxAllowedValue = &val
+	}
+
+	eventParams.GroupingInterval = durationUnits(params.GroupingInterval, diags)
+
+	if !params.CheckNumPoint.IsNull() && !params.CheckNumPoint.IsUnknown() {
+		val := int(params.CheckNumPoint.ValueInt64())
+		eventParams.CheckNumPoint = &val
+	}
+
+	return eventParams
+}
+
+// convertToUptimeParams converts uptime params to UptimeMonitorParams.
+//
+//nolint:gocritic // Params passed by value to avoid pointer complexity in conversion
+func convertToUptimeParams(ctx context.Context, params UptimeMonitorParamsModel, result *generated.MonitorInput_Params, diags *diag.Diagnostics) {
+	uptimeParams := generated.UptimeMonitorParams{
+		Url: params.URL.ValueString(),
+	}
+
+	if !params.Method.IsNull() && !params.Method.IsUnknown() {
+		method := generated.UptimeMonitorParamsMethod(params.Method.ValueString())
+		uptimeParams.Method = &method
+	}
+
+	if !params.Headers.IsNull() && !params.Headers.IsUnknown() {
+		headers := make(map[string]string, len(params.Headers.Elements()))
+		diags.Append(params.Headers.ElementsAs(ctx, &headers, false)...)
+		uptimeParams.Headers = &headers
+	}
+
+	if !params.Body.IsNull() {
+		body := params.Body.ValueString()
+		uptimeParams.Body = &body
+	}
+
+	if !params.ExpectedStatusCodes.IsNull() && !params.ExpectedStatusCodes.IsUnknown() {
+		var codes []int
+		diags.Append(params.ExpectedStatusCodes.ElementsAs(ctx, &codes, false)...)
+		uptimeParams.ExpectedStatusCodes = &codes
+	}
+
+	if !params.CheckInterval.IsNull() && !params.CheckInterval.IsUnknown() {
+		val := params.CheckInterval.ValueInt64()
+		uptimeParams.CheckInterval = &val
+	}
+
+	if !params.Timeout.IsNull() && !params.Timeout.IsUnknown() {
+		val := params.Timeout.ValueInt64()
+		uptimeParams.Timeout = &val
+	}
+
+	if !params.FollowRedirects.IsNull() && !params.FollowRedirects.IsUnknown() {
+		val := params.FollowRedirects.ValueBool()
+		uptimeParams.FollowRedirects = &val
+	}
+
+	if diags.HasError() {
+		return
+	}
+	if err := result.FromUptimeMonitorParams(uptimeParams); err != nil {
+		diags.AddError("Failed to convert uptime params", err.Error())
+	}
+}
+
 // monitorToState converts an API Monitor to Terraform state.
 func monitorToState(ctx context.Context, monitor *generated.Monitor, state *MonitorResourceModel, diags *diag.Diagnostics) {
 	state.ID = types.StringValue(fmt.Sprintf("%d", monitor.Id))
 	state.Name = types.StringValue(monitor.Name)
 	state.Type = types.StringValue(string(monitor.Type))
 	state.State = types.StringValue(string(monitor.State))
+	state.Paused = types.BoolValue(monitor.State == generated.MonitorStatePaused)
 
 	// Convert optional fields
 	if monitor.NotifyEveryoneByEmail != nil {
@@ -238,7 +638,7 @@ func monitorToState(ctx context.Context, monitor *generated.Monitor, state *Moni
 	}
 
 	// Convert trend aggregation function (cloud API only)
-	if monitor.TrendAggFunc != nil {
+	if monitor.TrendAggFunc != nil && *monitor.TrendAggFunc != "" {
 		state.TrendAggFunc = types.StringValue(*monitor.TrendAggFunc)
 	} else {
 		state.TrendAggFunc = types.StringNull()
@@ -267,25 +667,7 @@ func monitorToState(ctx context.Context, monitor *generated.Monitor, state *Moni
 	}
 
 	// Convert repeat interval
-	if monitor.RepeatInterval != nil {
-		riAttrs := map[string]attr.Value{
-			"strategy": types.StringNull(),
-			"interval": types.Int64Null(),
-		}
-		if monitor.RepeatInterval.Strategy != nil {
-			riAttrs["strategy"] = types.StringValue(string(*monitor.RepeatInterval.Strategy))
-		}
-		if monitor.RepeatInterval.Interval != nil {
-			riAttrs["interval"] = types.Int64Value(*monitor.RepeatInterval.Interval)
-		}
-		state.RepeatInterval = types.ObjectValueMust(
-			map[string]attr.Type{
-				"strategy": types.StringType,
-				"interval": types.Int64Type,
-			},
-			riAttrs,
-		)
-	}
+	convertRepeatIntervalToState(monitor.RepeatInterval, state)
 
 	// Convert params - always present
 	convertParamsToState(ctx, monitor, state, diags)
@@ -299,56 +681,241 @@ func monitorToState(ctx context.Context, monitor *generated.Monitor, state *Moni
 	}
 }
 
+// convertRepeatIntervalToState converts the API repeat interval to Terraform state.
+func convertRepeatIntervalToState(repeatInterval *generated.RepeatInterval, state *MonitorResourceModel) {
+	if repeatInterval == nil {
+		return
+	}
+
+	riAttrs := map[string]attr.Value{
+		"strategy": types.StringNull(),
+		"interval": NewDurationNull(time.Second),
+	}
+	if repeatInterval.Strategy != nil {
+		riAttrs["strategy"] = types.StringValue(string(*repeatInterval.Strategy))
+	}
+	if repeatInterval.Interval != nil {
+		riAttrs["interval"] = NewDurationValueFromUnits(float64(*repeatInterval.Interval), time.Second)
+	}
+	state.RepeatInterval = types.ObjectValueMust(repeatIntervalAttrTypes, riAttrs)
+}
+
+// monitorToResourceState converts an API Monitor to resource state shaped after the prior state,
+// which is the plan on create and update. Only the params attribute used by the prior state is set,
+// and server defaults the prior state doesn't set are omitted.
+//
+//nolint:gocritic // Prior model passed by value to keep function signatures consistent
+func monitorToResourceState(ctx context.Context, monitor *generated.Monitor, prior MonitorResourceModel, state *MonitorResourceModel, diags *diag.Diagnostics) {
+	monitorToState(ctx, monitor, state, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// The deprecated params attribute is only kept for configurations that still use it.
+	// Imported monitors use the typed attribute matching their type.
+	if isKnownObject(prior.Params) {
+		state.MetricParams = types.ObjectNull(metricParamsAttrTypes)
+		state.ErrorParams = types.ObjectNull(errorParamsAttrTypes)
+		state.SpanParams = types.ObjectNull(spanParamsAttrTypes)
+		state.LogParams = types.ObjectNull(logParamsAttrTypes)
+		state.UptimeParams = types.ObjectNull(uptimeParamsAttrTypes)
+	} else {
+		state.Params = types.ObjectNull(monitorParamsAttrTypes)
+	}
+
+	state.MetricParams = shapeMetricThresholds(prior.MetricParams, state.MetricParams)
+	omitMonitorDefaults(ctx, prior, state)
+}
+
+// shapeMetricThresholds keeps the thresholds of the metric params in the form the prior params use:
+// the condition when the prior params set it, min_allowed_value and max_allowed_value otherwise.
+// check_num_point is kept as for_points only when the prior condition sets it.
+func shapeMetricThresholds(prior, params types.Object) types.Object {
+	if !isKnownObject(params) {
+		return params
+	}
+
+	priorCondition := types.ObjectNull(metricConditionAttrTypes)
+	if isKnownObject(prior) {
+		if condition, ok := prior.Attributes()["condition"].(types.Object); ok {
+			priorCondition = condition
+		}
+	}
+
+	attrs := params.Attributes()
+	if priorCondition.IsNull() {
+		attrs["condition"] = types.ObjectNull(metricConditionAttrTypes)
+		return types.ObjectValueMust(metricParamsAttrTypes, attrs)
+	}
+
+	attrs["min_allowed_value"] = types.Float64Null()
+	attrs["max_allowed_value"] = types.Float64Null()
+	condition, _ := attrs["condition"].(types.Object)
+	if isKnownObject(priorCondition) && isKnownObject(condition) && priorCondition.Attributes()["for_points"].IsNull() {
+		conditionAttrs := condition.Attributes()
+		conditionAttrs["for_points"] = types.Int64Null()
+		attrs["condition"] = types.ObjectValueMust(metricConditionAttrTypes, conditionAttrs)
+	}
+	return types.ObjectValueMust(metricParamsAttrTypes, attrs)
+}
+
+// omitMonitorDefaults removes values Uptrace fills in on its own from the state unless
+// the prior state sets them. After an import the prior state is empty, so the state,
+// and the configuration Terraform generates from it, only holds what differs from the defaults.
+//
+//nolint:gocritic // Prior model passed by value to keep function signatures consistent
+func omitMonitorDefaults(ctx context.Context, prior MonitorResourceModel, state *MonitorResourceModel) {
+	priorUnset := prior.RepeatInterval.IsNull() || prior.RepeatInterval.IsUnknown()
+	if state.RepeatInterval.IsUnknown() || (priorUnset && isDefaultRepeatInterval(state.RepeatInterval)) {
+		state.RepeatInterval = types.ObjectNull(repeatIntervalAttrTypes)
+	}
+
+	state.Params = omitParamDefaults(ctx, prior.Params, state.Params, monitorParamDefaults)
+	state.MetricParams = omitParamDefaults(ctx, prior.MetricParams, state.MetricParams, monitorParamDefaults)
+	state.SpanParams = omitParamDefaults(ctx, prior.SpanParams, state.SpanParams, monitorParamDefaults)
+	state.LogParams = omitParamDefaults(ctx, prior.LogParams, state.LogParams, monitorParamDefaults)
+	state.UptimeParams = omitParamDefaults(ctx, prior.UptimeParams, state.UptimeParams, uptimeParamDefaults)
+}
+
+// omitParamDefaults nulls the params holding their server default in defaults unless the prior params set them.
+func omitParamDefaults(ctx context.Context, prior, params types.Object, defaults map[string]attr.Value) types.Object {
+	if !isKnownObject(params) {
+		return params
+	}
+
+	var priorAttrs map[string]attr.Value
+	if isKnownObject(prior) {
+		priorAttrs = prior.Attributes()
+	}
+
+	attrs := params.Attributes()
+	for key, def := range defaults {
+		if priorValue, ok := priorAttrs[key]; ok && !priorValue.IsNull() && !priorValue.IsUnknown() {
+			continue
+		}
+		if value, ok := attrs[key]; ok && value.Equal(def) {
+			attrs[key] = nullValueOf(def)
+		}
+	}
+	return types.ObjectValueMust(params.AttributeTypes(ctx), attrs)
+}
+
+// isDefaultRepeatInterval reports whether a repeat interval uses the default strategy without a custom interval.
+func isDefaultRepeatInterval(repeatInterval types.Object) bool {
+	if repeatInterval.IsUnknown() {
+		return false
+	}
+	if repeatInterval.IsNull() {
+		return true
+	}
+
+	attrs := repeatInterval.Attributes()
+	strategy, _ := attrs["strategy"].(types.String)
+	interval, _ := attrs["interval"].(DurationValue)
+	return interval.IsNull() &&
+		(strategy.IsNull() || strategy.ValueString() == string(generated.RepeatIntervalStrategyDefault))
+}
+
 // convertParamsToState converts API params to Terraform state.
+// The params attribute only holds the params relevant to the monitor type, and
+// the typed attribute matching the monitor type is set.
 func convertParamsToState(_ context.Context, monitor *generated.Monitor, state *MonitorResourceModel, diags *diag.Diagnostics) {
 	paramsAttrs := map[string]attr.Value{
-		"metrics":           types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "alias": types.StringType}}),
-		"query":             types.StringNull(),
+		"metrics":           types.ListNull(types.ObjectType{AttrTypes: metricDefinitionAttrTypes}),
+		"query":             NewUQLQueryNull(),
 		"column":            types.StringNull(),
+		"condition":         types.ObjectNull(metricConditionAttrTypes),
 		"min_allowed_value": types.Float64Null(),
 		"max_allowed_value": types.Float64Null(),
-		"grouping_interval": types.Float64Null(),
+		"grouping_interval": NewDurationNull(time.Millisecond),
 		"check_num_point":   types.Int64Null(),
 		"nulls_mode":        types.StringNull(),
-		"time_offset":       types.Float64Null(),
+		"time_offset":       NewDurationNull(time.Millisecond),
 	}
+	state.MetricParams = types.ObjectNull(metricParamsAttrTypes)
+	state.ErrorParams = types.ObjectNull(errorParamsAttrTypes)
+	state.SpanParams = types.ObjectNull(spanParamsAttrTypes)
+	state.LogParams = types.ObjectNull(logParamsAttrTypes)
+	state.UptimeParams = types.ObjectNull(uptimeParamsAttrTypes)
 
-	if monitor.Type == generated.MonitorTypeMetric {
+	switch monitor.Type {
+	case generated.MonitorTypeMetric:
 		metricParams, err := monitor.Params.AsMetricMonitorParams()
 		if err == nil {
 			convertMetricParamsToAttrs(metricParams, paramsAttrs)
+			state.MetricParams = types.ObjectValueMust(metricParamsAttrTypes, paramsAttrs)
 		} else {
 			diags.AddWarning("Failed to parse metric params", err.Error())
 		}
-	} else if monitor.Type == generated.MonitorTypeError {
+	case generated.MonitorTypeError:
 		errorParams, err := monitor.Params.AsErrorMonitorParams()
 		if err == nil {
 			convertErrorParamsToAttrs(errorParams, paramsAttrs)
+			state.ErrorParams = types.ObjectValueMust(errorParamsAttrTypes, map[string]attr.Value{
+				"metrics": paramsAttrs["metrics"],
+				"query":   uqlQueryValueOrNull(errorParams.Query),
+			})
 		} else {
 			diags.AddWarning("Failed to parse error params", err.Error())
 		}
+	case generated.MonitorTypeSpan:
+		spanParams, err := monitor.Params.AsSpanMonitorParams()
+		if err == nil {
+			convertEventParamsToAttrs(spanParams, paramsAttrs)
+			state.SpanParams = types.ObjectValueMust(spanParamsAttrTypes, selectAttrs(paramsAttrs, spanParamsAttrTypes))
+		} else {
+			diags.AddWarning("Failed to parse span params", err.Error())
+		}
+	case generated.MonitorTypeLog:
+		logParams, err := monitor.Params.AsLogMonitorParams()
+		if err == nil {
+			convertEventParamsToAttrs(generated.SpanMonitorParams(logParams), paramsAttrs)
+			state.LogParams = types.ObjectValueMust(logParamsAttrTypes, selectAttrs(paramsAttrs, logParamsAttrTypes))
+		} else {
+			diags.AddWarning("Failed to parse log params", err.Error())
+		}
+	case generated.MonitorTypeUptime:
+		uptimeParams, err := monitor.Params.AsUptimeMonitorParams()
+		if err == nil {
+			state.UptimeParams = convertUptimeParamsToObject(uptimeParams)
+		} else {
+			diags.AddWarning("Failed to parse uptime params", err.Error())
+		}
 	}
 
-	state.Params = types.ObjectValueMust(
-		map[string]attr.Type{
-			"metrics":           types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "alias": types.StringType}}},
-			"query":             types.StringType,
-			"column":            types.StringType,
-			"min_allowed_value": types.Float64Type,
-			"max_allowed_value": types.Float64Type,
-			"grouping_interval": types.Float64Type,
-			"check_num_point":   types.Int64Type,
-			"nulls_mode":        types.StringType,
-			"time_offset":       types.Float64Type,
-		},
-		paramsAttrs,
-	)
+	state.Params = types.ObjectValueMust(monitorParamsAttrTypes, selectAttrs(paramsAttrs, monitorParamsAttrTypes))
+}
+
+// uqlQueryValueOrNull converts an optional API query. An empty query is null.
+func uqlQueryValueOrNull(s *string) UQLQueryValue {
+	if s == nil || *s == "" {
+		return NewUQLQueryNull()
+	}
+	return NewUQLQueryValue(*s)
+}
+
+// nullValueOf returns the null value of the same type as v.
+func nullValueOf(v attr.Value) attr.Value {
+	switch v := v.(type) {
+	case DurationValue:
+		return NewDurationNull(v.unit)
+	case types.Int64:
+		return types.Int64Null()
+	case types.Float64:
+		return types.Float64Null()
+	case types.Bool:
+		return types.BoolNull()
+	case types.List:
+		return types.ListNull(v.ElementType(context.Background()))
+	default:
+		return types.StringNull()
+	}
 }
 
 // convertMetricsToListValue converts a slice of generated.MetricDefinition to a Terraform list value.
 // This is a shared helper used by both metric and error monitor parameter conversions.
 func convertMetricsToListValue(metrics []generated.MetricDefinition) types.List {
-	metricAttrTypes := map[string]attr.Type{"name": types.StringType, "alias": types.StringType}
+	metricAttrTypes := metricDefinitionAttrTypes
 
 	if len(metrics) == 0 {
 		return types.ListValueMust(types.ObjectType{AttrTypes: metricAttrTypes}, []attr.Value{})
@@ -369,6 +936,7 @@ func convertMetricsToListValue(metrics []generated.MetricDefinition) types.List
 }
 
 // convertMetricParamsToAttrs converts metric params to attribute map.
+// The allowed value range is set both as is and as the condition alerting outside of it.
 //
 //nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
 func convertMetricParamsToAttrs(params generated.MetricMonitorParams, attrs map[string]attr.Value) {
@@ -376,7 +944,7 @@ func convertMetricParamsToAttrs(params generated.MetricMonitorParams, attrs map[
 	attrs["metrics"] = convertMetricsToListValue(params.Metrics)
 
 	if params.Query != "" {
-		attrs["query"] = types.StringValue(params.Query)
+		attrs["query"] = NewUQLQueryValue(params.Query)
 	}
 	if params.Column != "" {
 		attrs["column"] = types.StringValue(params.Column)
@@ -387,8 +955,9 @@ func convertMetricParamsToAttrs(params generated.MetricMonitorParams, attrs map[
 	if params.MaxAllowedValue != nil {
 		attrs["max_allowed_value"] = types.Float64Value(*params.MaxAllowedValue)
 	}
+	attrs["condition"] = allowedRangeToCondition(params.MinAllowedValue, params.MaxAllowedValue, params.CheckNumPoint)
 	if params.GroupingInterval != nil {
-		attrs["grouping_interval"] = types.Float64Value(*params.GroupingInterval)
+		attrs["grouping_interval"] = NewDurationValueFromUnits(*params.GroupingInterval, time.Millisecond)
 	}
 	if params.CheckNumPoint != nil {
 		attrs["check_num_point"] = types.Int64Value(int64(*params.CheckNumPoint))
@@ -397,7 +966,7 @@ func convertMetricParamsToAttrs(params generated.MetricMonitorParams, attrs map[
 		attrs["nulls_mode"] = types.StringValue(string(*params.NullsMode))
 	}
 	if params.TimeOffset != nil {
-		attrs["time_offset"] = types.Float64Value(*params.TimeOffset)
+		attrs["time_offset"] = NewDurationValueFromUnits(*params.TimeOffset, time.Millisecond)
 	}
 }
 
@@ -407,6 +976,79 @@ func convertErrorParamsToAttrs(params generated.ErrorMonitorParams, attrs map[st
 	attrs["metrics"] = convertMetricsToListValue(params.Metrics)
 
 	if params.Query != nil {
-		attrs["query"] = types.StringValue(*params.Query)
+		attrs["query"] = NewUQLQueryValue(*params.Query)
+	}
+}
+
+// convertEventParamsToAttrs converts span or log params to attribute map.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func convertEventParamsToAttrs(params generated.SpanMonitorParams, attrs map[string]attr.Value) {
+	if params.Query != "" {
+		attrs["query"] = NewUQLQueryValue(params.Query)
+	}
+	if params.Column != "" {
+		attrs["column"] = types.StringValue(params.Column)
+	}
+	attrs["min_allowed_value"] = types.Float64PointerValue(params.MinAllowedValue)
+	attrs["max_allowed_value"] = types.Float64PointerValue(params.MaxAllowedValue)
+	attrs["grouping_interval"] = NewDurationPointerValueFromUnits(params.GroupingInterval, time.Millisecond)
+	if params.CheckNumPoint != nil {
+		attrs["check_num_point"] = types.Int64Value(int64(*params.CheckNumPoint))
+	}
+}
+
+// convertUptimeParamsToObject converts uptime params to an uptime_params object.
+// Params Uptrace doesn't return hold their default.
+//
+//nolint:gocritic // Generated params type passed by value to match oapi-codegen patterns
+func convertUptimeParamsToObject(params generated.UptimeMonitorParams) types.Object {
+	attrs := map[string]attr.Value{
+		"url":                   types.StringValue(params.Url),
+		"method":                uptimeParamDefaults["method"],
+		"headers":               types.MapNull(types.StringType),
+		"body":                  stringValueOrNull(params.Body),
+		"expected_status_codes": uptimeParamDefaults["expected_status_codes"],
+		"check_interval":        uptimeParamDefaults["check_interval"],
+		"timeout":               uptimeParamDefaults["timeout"],
+		"follow_redirects":      uptimeParamDefaults["follow_redirects"],
+	}
+
+	if params.Method != nil && *params.Method != "" {
+		attrs["method"] = types.StringValue(string(*params.Method))
+	}
+	if params.Headers != nil && len(*params.Headers) > 0 {
+		headers := make(map[string]attr.Value, len(*params.Headers))
+		for name, value := range *params.Headers {
+			headers[name] = types.StringValue(value)
+		}
+		attrs["headers"] = types.MapValueMust(types.StringType, headers)
+	}
+	if params.ExpectedStatusCodes != nil && len(*params.ExpectedStatusCodes) > 0 {
+		codes := make([]attr.Value, len(*params.ExpectedStatusCodes))
+		for i, code := range *params.ExpectedStatusCodes {
+			codes[i] = types.Int64Value(int64(code))
+		}
+		attrs["expected_status_codes"] = types.ListValueMust(types.Int64Type, codes)
+	}
+	if params.CheckInterval != nil {
+		attrs["check_interval"] = types.Int64Value(*params.CheckInterval)
+	}
+	if params.Timeout != nil {
+		attrs["timeout"] = types.Int64Value(*params.Timeout)
+	}
+	if params.FollowRedirects != nil {
+		attrs["follow_redirects"] = types.BoolValue(*params.FollowRedirects)
+	}
+
+	return types.ObjectValueMust(uptimeParamsAttrTypes, attrs)
+}
+
+// selectAttrs returns the attributes of attrs that are part of attrTypes.
+func selectAttrs(attrs map[string]attr.Value, attrTypes map[string]attr.Type) map[string]attr.Value {
+	selected := make(map[string]attr.Value, len(attrTypes))
+	for key := range attrTypes {
+		selected[key] = attrs[key]
 	}
+	return selected
 }
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,13 +39,13 @@ func TestPlanToMonitorInput_MetricMonitor(t *testing.T) {
 			}},
 			[]attr.Value{metricObj},
 		),
-		"query":             types.StringValue("avg($cpu) > 80"),
+		"query":             NewUQLQueryValue("avg($cpu) > 80"),
 		"column":            types.StringValue("value"),
 		"max_allowed_value": types.Float64Value(80),
 		"check_num_point":   types.Int64Value(2),
 		"nulls_mode":        types.StringValue("allow"),
-		"grouping_interval": types.Float64Value(60000),
-		"time_offset":       types.Float64Value(0),
+		"grouping_interval": NewDurationValue("60000", time.Millisecond),
+		"time_offset":       NewDurationValue("30s", time.Millisecond),
 		"min_allowed_value": types.Float64Null(),
 	}
 
@@ -53,14 +54,14 @@ func TestPlanToMonitorInput_MetricMonitor(t *testing.T) {
 			"name":  types.StringType,
 			"alias": types.StringType,
 		}}},
-		"query":             types.StringType,
+		"query":             UQLQueryType{},
 		"column":            types.StringType,
 		"max_allowed_value": types.Float64Type,
 		"min_allowed_value": types.Float64Type,
-		"grouping_interval": types.Float64Type,
+		"grouping_interval": durationMillisecondsType,
 		"check_num_point":   types.Int64Type,
 		"nulls_mode":        types.StringType,
-		"time_offset":       types.Float64Type,
+		"time_offset":       durationMillisecondsType,
 	}
 
 	plan := MonitorResourceModel{
@@ -89,6 +90,8 @@ func TestPlanToMonitorInput_MetricMonitor(t *testing.T) {
 	assert.Equal(t, "$cpu", *metricParams.Metrics[0].Alias)
 	assert.Equal(t, "avg($cpu) > 80", metricParams.Query)
 	assert.Equal(t, float64(80), *metricParams.MaxAllowedValue)
+	assert.Equal(t, float64(60000), *metricParams.GroupingInterval)
+	assert.Equal(t, float64(30000), *metricParams.TimeOffset)
 }
 
 func TestPlanToMonitorInput_ErrorMonitor(t *testing.T) {
@@ -116,14 +119,14 @@ func TestPlanToMonitorInput_ErrorMonitor(t *testing.T) {
 			}},
 			[]attr.Value{metricObj},
 		),
-		"query":             types.StringValue("sum($logs) | where span.event_name exists"),
+		"query":             NewUQLQueryValue("sum($logs) | where span.event_name exists"),
 		"column":            types.StringNull(),
 		"max_allowed_value": types.Float64Null(),
 		"min_allowed_value": types.Float64Null(),
-		"grouping_interval": types.Float64Null(),
+		"grouping_interval": NewDurationNull(time.Millisecond),
 		"check_num_point":   types.Int64Null(),
 		"nulls_mode":        types.StringNull(),
-		"time_offset":       types.Float64Null(),
+		"time_offset":       NewDurationNull(time.Millisecond),
 	}
 
 	paramsType := map[string]attr.Type{
@@ -131,14 +134,14 @@ func TestPlanToMonitorInput_ErrorMonitor(t *testing.T) {
 			"name":  types.StringType,
 			"alias": types.StringType,
 		}}},
-		"query":             types.StringType,
+		"query":             UQLQueryType{},
 		"column":            types.StringType,
 		"max_allowed_value": types.Float64Type,
 		"min_allowed_value": types.Float64Type,
-		"grouping_interval": types.Float64Type,
+		"grouping_interval": durationMillisecondsType,
 		"check_num_point":   types.Int64Type,
 		"nulls_mode":        types.StringType,
-		"time_offset":       types.Float64Type,
+		"time_offset":       durationMillisecondsType,
 	}
 
 	plan := MonitorResourceModel{
@@ -265,3 +268,531 @@ func TestMonitorToState_ErrorMonitor(t *testing.T) {
 	assert.Equal(t, "firing", state.State.ValueString())
 	assert.False(t, state.NotifyEveryoneByEmail.ValueBool())
 }
+
+// newImportedMetricMonitor returns a metric monitor as Uptrace reports it, with server defaults filled in.
+func newImportedMetricMonitor() *generated.Monitor {
+	maxValue := float64(90)
+	checkNumPoint := 1
+	nullsMode := generated.MetricMonitorParamsNullsModeAllow
+	groupingInterval := float64(60000)
+	timeOffset := float64(0)
+	strategy := generated.RepeatIntervalStrategyDefault
+	alias := "$cpu"
+	notifyEmail := false
+
+	var params generated.Monitor_Params
+	_ = params.FromMetricMonitorParams(generated.MetricMonitorParams{
+		Metrics:          []generated.MetricDefinition{{Name: "system.cpu.utilization", Alias: &alias}},
+		Query:            "avg($cpu) > 90",
+		Column:           "$cpu",
+		MaxAllowedValue:  &maxValue,
+		CheckNumPoint:    &checkNumPoint,
+		NullsMode:        &nullsMode,
+		GroupingInterval: &groupingInterval,
+		TimeOffset:       &timeOffset,
+	})
+
+	return &generated.Monitor{
+		Id:                    123,
+		Name:                  "High CPU",
+		Type:                  generated.MonitorTypeMetric,
+		State:                 generated.MonitorStateOpen,
+		NotifyEveryoneByEmail: &notifyEmail,
+		TeamIds:               &[]int64{},
+		ChannelIds:            &[]int64{},
+		RepeatInterval:        &generated.RepeatInterval{Strategy: &strategy},
+		Params:                params,
+	}
+}
+
+func TestOmitMonitorDefaults_Import(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// Right after an import only the ID is known
+	prior := MonitorResourceModel{ID: types.StringValue("123")}
+	state := prior
+	monitorToState(ctx, newImportedMetricMonitor(), &state, &diags)
+	require.False(t, diags.HasError())
+	omitMonitorDefaults(ctx, prior, &state)
+
+	assert.True(t, state.RepeatInterval.IsNull(), "Default repeat interval should be omitted")
+	assert.True(t, state.TrendAggFunc.IsNull(), "Unset trend_agg_func should be omitted")
+
+	params := state.Params.Attributes()
+	assert.Equal(t, NewUQLQueryValue("avg($cpu) > 90"), params["query"])
+	assert.Equal(t, types.StringValue("$cpu"), params["column"])
+	assert.Equal(t, types.Float64Value(90), params["max_allowed_value"])
+	for _, key := range []string{"min_allowed_value", "check_num_point", "nulls_mode", "grouping_interval", "time_offset"} {
+		assert.True(t, params[key].IsNull(), "Param %s should be omitted", key)
+	}
+}
+
+func TestOmitMonitorDefaults_KeepsConfiguredDefaults(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// The first conversion stands in for a configuration that sets every default explicitly
+	var prior MonitorResourceModel
+	monitorToState(ctx, newImportedMetricMonitor(), &prior, &diags)
+	require.False(t, diags.HasError())
+
+	state := prior
+	monitorToState(ctx, newImportedMetricMonitor(), &state, &diags)
+	require.False(t, diags.HasError())
+	omitMonitorDefaults(ctx, prior, &state)
+
+	assert.Equal(t, "default", state.RepeatInterval.Attributes()["strategy"].(types.String).ValueString())
+	params := state.Params.Attributes()
+	assert.Equal(t, types.Int64Value(1), params["check_num_point"])
+	assert.Equal(t, types.StringValue("allow"), params["nulls_mode"])
+	assert.Equal(t, NewDurationValue("1m", time.Millisecond), params["grouping_interval"])
+	assert.Equal(t, NewDurationValue("0s", time.Millisecond), params["time_offset"])
+}
+
+func TestOmitMonitorDefaults_ErrorMonitorHasNoMetricParams(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	query := "sum($logs) | where span.event_name exists"
+
+	var params generated.Monitor_Params
+	_ = params.FromErrorMonitorParams(generated.ErrorMonitorParams{
+		Metrics: []generated.MetricDefinition{{Name: "uptrace_tracing_events"}},
+		Query:   &query,
+	})
+	monitor := &generated.Monitor{Id: 456, Name: "Errors", Type: generated.MonitorTypeError, Params: params}
+
+	prior := MonitorResourceModel{ID: types.StringValue("456")}
+	state := prior
+	monitorToState(ctx, monitor, &state, &diags)
+	require.False(t, diags.HasError())
+	omitMonitorDefaults(ctx, prior, &state)
+
+	for key, value := range state.Params.Attributes() {
+		if key == "metrics" || key == "query" {
+			assert.False(t, value.IsNull(), "Param %s should be set", key)
+			continue
+		}
+		assert.True(t, value.IsNull(), "Metric-only param %s should be omitted for error monitors", key)
+	}
+}
+
+// TestMonitorImportRoundTrip verifies that the configuration generated from an
+// imported monitor produces the same API input as the monitor it came from.
+func TestMonitorImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	monitor := newImportedMetricMonitor()
+
+	prior := MonitorResourceModel{ID: types.StringValue("123")}
+	imported := prior
+	monitorToResourceState(ctx, monitor, prior, &imported, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, imported.Params.IsNull(), "Imported monitors should not use the deprecated params")
+	assert.True(t, imported.ErrorParams.IsNull())
+	require.False(t, imported.MetricParams.IsNull())
+
+	// Terraform generates configuration from the imported state, then plans it
+	input := planToMonitorInput(ctx, imported, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, monitor.Name, input.Name)
+	assert.Equal(t, monitor.Type, input.Type)
+	assert.Nil(t, input.RepeatInterval)
+	assert.Nil(t, input.TrendAggFunc)
+
+	want, err := monitor.Params.AsMetricMonitorParams()
+	require.NoError(t, err)
+	got, err := input.Params.AsMetricMonitorParams()
+	require.NoError(t, err)
+
+	assert.Equal(t, want.Metrics, got.Metrics)
+	assert.Equal(t, want.Query, got.Query)
+	assert.Equal(t, want.Column, got.Column)
+	assert.Equal(t, want.MaxAllowedValue, got.MaxAllowedValue)
+	assert.Nil(t, got.CheckNumPoint, "Server defaults should be left to Uptrace")
+	assert.Nil(t, got.NullsMode)
+	assert.Nil(t, got.GroupingInterval)
+	assert.Nil(t, got.TimeOffset)
+
+	// Reading the monitor back after applying the generated configuration yields no changes
+	refreshed := imported
+	monitorToResourceState(ctx, monitor, imported, &refreshed, &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, imported, refreshed)
+}
+
+// testMetricsList returns a metrics list with a single aliased metric.
+func testMetricsList(name, alias string) types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: metricDefinitionAttrTypes}, []attr.Value{
+		types.ObjectValueMust(metricDefinitionAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(name),
+			"alias": types.StringValue(alias),
+		}),
+	})
+}
+
+func TestPlanToMonitorInput_MetricParams(t *testing.T) {
+	ctx := context.Background()
+
+	plan := MonitorResourceModel{
+		Name:        types.StringValue("High CPU"),
+		Type:        types.StringValue("metric"),
+		Params:      types.ObjectNull(monitorParamsAttrTypes),
+		ErrorParams: types.ObjectNull(errorParamsAttrTypes),
+		MetricParams: types.ObjectValueMust(metricParamsAttrTypes, map[string]attr.Value{
+			"metrics":           testMetricsList("system.cpu.utilization", "$cpu"),
+			"query":             NewUQLQueryValue("avg($cpu)"),
+			"column":            types.StringValue("avg($cpu)"),
+			"condition":         types.ObjectNull(metricConditionAttrTypes),
+			"min_allowed_value": types.Float64Null(),
+			"max_allowed_value": types.Float64Value(90),
+			"grouping_interval": NewDurationUnknown(time.Millisecond),
+			"check_num_point":   types.Int64Value(3),
+			"nulls_mode":        types.StringUnknown(),
+			"time_offset":       NewDurationUnknown(time.Millisecond),
+		}),
+	}
+
+	diags := diag.Diagnostics{}
+	input := planToMonitorInput(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+
+	metricParams, err := input.Params.AsMetricMonitorParams()
+	require.NoError(t, err)
+	assert.Equal(t, "avg($cpu)", metricParams.Query)
+	assert.Equal(t, "avg($cpu)", metricParams.Column)
+	assert.Equal(t, "$cpu", *metricParams.Metrics[0].Alias)
+	assert.Equal(t, float64(90), *metricParams.MaxAllowedValue)
+	assert.Equal(t, 3, *metricParams.CheckNumPoint)
+	assert.Nil(t, metricParams.MinAllowedValue)
+}
+
+func TestPlanToMonitorInput_MetricCondition(t *testing.T) {
+	low, high := 10.0, 90.0
+	tests := []struct {
+		name    string
+		params  types.Object
+		wantMin *float64
+		wantMax *float64
+	}{
+		{name: "above", params: testConditionParams("above", &high, nil, nil), wantMax: &high},
+		{name: "below", params: testConditionParams("below", &low, nil, nil), wantMin: &low},
+		{name: "outside", params: testConditionParams("outside", nil, &low, &high), wantMin: &low, wantMax: &high},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := MonitorResourceModel{
+				Name:         types.StringValue("High CPU"),
+				Type:         types.StringValue("metric"),
+				MetricParams: tt.params,
+			}
+
+			diags := diag.Diagnostics{}
+			input := planToMonitorInput(context.Background(), plan, &diags)
+			require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+
+			metricParams, err := input.Params.AsMetricMonitorParams()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMin, metricParams.MinAllowedValue)
+			assert.Equal(t, tt.wantMax, metricParams.MaxAllowedValue)
+		})
+	}
+}
+
+func TestMonitorToResourceState_MetricCondition(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	high := 90.0
+	monitor := newImportedMetricMonitor()
+
+	// Reading the monitor keeps the condition a configuration uses
+	prior := MonitorResourceModel{ID: types.StringValue("123"), MetricParams: testConditionParams("above", &high, nil, nil)}
+	state := prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	metricParams := state.MetricParams.Attributes()
+	assert.Equal(t, prior.MetricParams.Attributes()["condition"], metricParams["condition"])
+	assert.True(t, metricParams["min_allowed_value"].IsNull())
+	assert.True(t, metricParams["max_allowed_value"].IsNull())
+
+	// Imported monitors use the allowed value range
+	imported := MonitorResourceModel{ID: types.StringValue("123")}
+	monitorToResourceState(ctx, monitor, MonitorResourceModel{ID: types.StringValue("123")}, &imported, &diags)
+	require.False(t, diags.HasError())
+
+	metricParams = imported.MetricParams.Attributes()
+	assert.True(t, metricParams["condition"].IsNull())
+	assert.Equal(t, types.Float64Value(90), metricParams["max_allowed_value"])
+}
+
+func TestAllowedRangeToCondition(t *testing.T) {
+	low, high := 10.0, 90.0
+	condition := func(operator string, value, lower, upper *float64) attr.Value {
+		return testConditionParams(operator, value, lower, upper).Attributes()["condition"]
+	}
+
+	assert.Equal(t, condition("above", &high, nil, nil), allowedRangeToCondition(nil, &high, nil))
+	assert.Equal(t, condition("below", &low, nil, nil), allowedRangeToCondition(&low, nil, nil))
+	assert.Equal(t, condition("outside", nil, &low, &high), allowedRangeToCondition(&low, &high, nil))
+	assert.True(t, allowedRangeToCondition(nil, nil, nil).IsNull())
+
+	checkNumPoint := 3
+	forPoints := allowedRangeToCondition(nil, &high, &checkNumPoint)
+	assert.Equal(t, types.Int64Value(3), forPoints.Attributes()["for_points"])
+}
+
+func TestMetricConditionForPoints(t *testing.T) {
+	ctx := context.Background()
+	high := 90.0
+
+	params := testConditionParams("above", &high, nil, nil)
+	attrs := params.Attributes()
+	conditionAttrs := attrs["condition"].(types.Object).Attributes()
+	conditionAttrs["for_points"] = types.Int64Value(3)
+	attrs["condition"] = types.ObjectValueMust(metricConditionAttrTypes, conditionAttrs)
+	params = types.ObjectValueMust(metricParamsAttrTypes, attrs)
+
+	// for_points is sent as check_num_point
+	plan := MonitorResourceModel{Name: types.StringValue("High CPU"), Type: types.StringValue("metric"), MetricParams: params}
+	diags := diag.Diagnostics{}
+	input := planToMonitorInput(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+	metricParams, err := input.Params.AsMetricMonitorParams()
+	require.NoError(t, err)
+	require.NotNil(t, metricParams.CheckNumPoint)
+	assert.Equal(t, 3, *metricParams.CheckNumPoint)
+
+	// and read back from it, but only into a condition that sets it
+	monitor := newImportedMetricMonitor()
+	state := MonitorResourceModel{ID: types.StringValue("123"), MetricParams: params}
+	monitorToResourceState(ctx, monitor, state, &state, &diags)
+	require.False(t, diags.HasError())
+	condition := state.MetricParams.Attributes()["condition"].(types.Object)
+	assert.False(t, condition.Attributes()["for_points"].IsNull())
+
+	prior := MonitorResourceModel{ID: types.StringValue("123"), MetricParams: testConditionParams("above", &high, nil, nil)}
+	state = prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+	condition = state.MetricParams.Attributes()["condition"].(types.Object)
+	assert.True(t, condition.Attributes()["for_points"].IsNull())
+}
+
+func TestMonitorToState_RepeatIntervalDuration(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	strategy := generated.RepeatIntervalStrategyCustom
+	interval := int64(5400)
+
+	monitor := newImportedMetricMonitor()
+	monitor.RepeatInterval = &generated.RepeatInterval{Strategy: &strategy, Interval: &interval}
+
+	var state MonitorResourceModel
+	monitorToState(ctx, monitor, &state, &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, NewDurationValue("1h30m", time.Second), state.RepeatInterval.Attributes()["interval"])
+
+	// The duration is sent back to Uptrace in seconds
+	input := planToMonitorInput(ctx, state, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+	assert.Equal(t, int64(5400), *input.RepeatInterval.Interval)
+}
+
+func TestPlanToMonitorInput_ErrorParams(t *testing.T) {
+	ctx := context.Background()
+
+	plan := MonitorResourceModel{
+		Name:         types.StringValue("Errors"),
+		Type:         types.StringValue("error"),
+		Params:       types.ObjectNull(monitorParamsAttrTypes),
+		MetricParams: types.ObjectNull(metricParamsAttrTypes),
+		ErrorParams: types.ObjectValueMust(errorParamsAttrTypes, map[string]attr.Value{
+			"metrics": testMetricsList("uptrace_tracing_events", "$logs"),
+			"query":   NewUQLQueryNull(),
+		}),
+	}
+
+	diags := diag.Diagnostics{}
+	input := planToMonitorInput(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+
+	errorParams, err := input.Params.AsErrorMonitorParams()
+	require.NoError(t, err)
+	assert.Equal(t, "uptrace_tracing_events", errorParams.Metrics[0].Name)
+	assert.Nil(t, errorParams.Query)
+}
+
+func TestPlanToMonitorInput_LogParams(t *testing.T) {
+	ctx := context.Background()
+	high := 100.0
+
+	plan := MonitorResourceModel{
+		Name:      types.StringValue("Error Logs"),
+		Type:      types.StringValue("log"),
+		LogParams: testEventParams(nil, &high),
+	}
+
+	diags := diag.Diagnostics{}
+	input := planToMonitorInput(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+
+	logParams, err := input.Params.AsLogMonitorParams()
+	require.NoError(t, err)
+	assert.Equal(t, "count() | where _status_code = 'error'", logParams.Query)
+	assert.Equal(t, "count()", logParams.Column)
+	assert.Equal(t, high, *logParams.MaxAllowedValue)
+	assert.Nil(t, logParams.MinAllowedValue)
+	assert.Nil(t, logParams.CheckNumPoint)
+}
+
+func TestPlanToMonitorInput_UptimeParams(t *testing.T) {
+	ctx := context.Background()
+
+	plan := MonitorResourceModel{
+		Name: types.StringValue("Health Check"),
+		Type: types.StringValue("uptime"),
+		UptimeParams: types.ObjectValueMust(uptimeParamsAttrTypes, map[string]attr.Value{
+			"url":    types.StringValue("https://example.com/health"),
+			"method": types.StringValue("HEAD"),
+			"headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Authorization": types.StringValue("Bearer token"),
+			}),
+			"body":                  types.StringNull(),
+			"expected_status_codes": types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(200), types.Int64Value(204)}),
+			"check_interval":        types.Int64Value(30),
+			"timeout":               types.Int64Value(5),
+			"follow_redirects":      types.BoolValue(false),
+		}),
+	}
+
+	diags := diag.Diagnostics{}
+	input := planToMonitorInput(ctx, plan, &diags)
+	require.False(t, diags.HasError(), "Conversion should not produce errors: %v", diags)
+
+	uptimeParams, err := input.Params.AsUptimeMonitorParams()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/health", uptimeParams.Url)
+	assert.Equal(t, generated.UptimeMonitorParamsMethodHEAD, *uptimeParams.Method)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, *uptimeParams.Headers)
+	assert.Nil(t, uptimeParams.Body)
+	assert.Equal(t, []int{200, 204}, *uptimeParams.ExpectedStatusCodes)
+	assert.Equal(t, int64(30), *uptimeParams.CheckInterval)
+	assert.Equal(t, int64(5), *uptimeParams.Timeout)
+	assert.False(t, *uptimeParams.FollowRedirects)
+}
+
+func TestMonitorToResourceState_SpanParams(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	maxValue, groupingInterval, checkNumPoint := 2000.0, 60000.0, 1
+
+	var params generated.Monitor_Params
+	_ = params.FromSpanMonitorParams(generated.SpanMonitorParams{
+		Query:            "p99(_duration) | group by service_name",
+		Column:           "p99(_duration)",
+		MaxAllowedValue:  &maxValue,
+		GroupingInterval: &groupingInterval,
+		CheckNumPoint:    &checkNumPoint,
+	})
+	monitor := &generated.Monitor{Id: 7, Name: "Slow Spans", Type: generated.MonitorTypeSpan, Params: params}
+
+	prior := MonitorResourceModel{ID: types.StringValue("7")}
+	state := prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, state.Params.IsNull())
+	assert.True(t, state.MetricParams.IsNull())
+	assert.True(t, state.UptimeParams.IsNull())
+	spanParams := state.SpanParams.Attributes()
+	assert.Equal(t, NewUQLQueryValue("p99(_duration) | group by service_name"), spanParams["query"])
+	assert.Equal(t, types.Float64Value(2000), spanParams["max_allowed_value"])
+	assert.True(t, spanParams["min_allowed_value"].IsNull())
+	assert.True(t, spanParams["grouping_interval"].IsNull(), "Server defaults should be omitted")
+	assert.True(t, spanParams["check_num_point"].IsNull(), "Server defaults should be omitted")
+}
+
+func TestMonitorToResourceState_UptimeParamsDefaults(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	var params generated.Monitor_Params
+	_ = params.FromUptimeMonitorParams(generated.UptimeMonitorParams{Url: "https://example.com/health"})
+	monitor := &generated.Monitor{Id: 8, Name: "Health Check", Type: generated.MonitorTypeUptime, Params: params}
+
+	// Imported monitors leave the defaults out
+	prior := MonitorResourceModel{ID: types.StringValue("8")}
+	state := prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	uptimeParams := state.UptimeParams.Attributes()
+	assert.Equal(t, types.StringValue("https://example.com/health"), uptimeParams["url"])
+	for _, key := range []string{"method", "headers", "body", "expected_status_codes", "check_interval", "timeout", "follow_redirects"} {
+		assert.True(t, uptimeParams[key].IsNull(), "Param %s should be omitted", key)
+	}
+
+	// Defaults the configuration sets are kept
+	prior.UptimeParams = convertUptimeParamsToObject(generated.UptimeMonitorParams{Url: "https://example.com/health"})
+	state = prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	uptimeParams = state.UptimeParams.Attributes()
+	assert.Equal(t, types.StringValue("GET"), uptimeParams["method"])
+	assert.Equal(t, types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(200)}), uptimeParams["expected_status_codes"])
+	assert.Equal(t, types.Int64Value(60), uptimeParams["check_interval"])
+	assert.Equal(t, types.Int64Value(10), uptimeParams["timeout"])
+	assert.Equal(t, types.BoolValue(true), uptimeParams["follow_redirects"])
+	assert.True(t, uptimeParams["headers"].IsNull())
+	assert.True(t, uptimeParams["body"].IsNull())
+}
+
+func TestMonitorToResourceState_ErrorParams(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+	empty := ""
+
+	var params generated.Monitor_Params
+	_ = params.FromErrorMonitorParams(generated.ErrorMonitorParams{
+		Metrics: []generated.MetricDefinition{{Name: "uptrace_tracing_events"}},
+		Query:   &empty,
+	})
+	monitor := &generated.Monitor{Id: 456, Name: "Errors", Type: generated.MonitorTypeError, Params: params}
+
+	prior := MonitorResourceModel{ID: types.StringValue("456")}
+	state := prior
+	monitorToResourceState(ctx, monitor, prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, state.Params.IsNull())
+	assert.True(t, state.MetricParams.IsNull())
+	errorParams := state.ErrorParams.Attributes()
+	assert.True(t, errorParams["query"].IsNull(), "An empty query should be read as null")
+	assert.Len(t, errorParams["metrics"].(types.List).Elements(), 1)
+}
+
+func TestMonitorToResourceState_KeepsDeprecatedParams(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	// The first conversion stands in for a configuration that still uses params
+	var prior MonitorResourceModel
+	monitorToState(ctx, newImportedMetricMonitor(), &prior, &diags)
+	require.False(t, diags.HasError())
+	prior.MetricParams = types.ObjectNull(metricParamsAttrTypes)
+	prior.ErrorParams = types.ObjectNull(errorParamsAttrTypes)
+
+	state := prior
+	monitorToResourceState(ctx, newImportedMetricMonitor(), prior, &state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.False(t, state.Params.IsNull(), "Configurations using params should keep it")
+	assert.True(t, state.MetricParams.IsNull())
+	assert.True(t, state.ErrorParams.IsNull())
+	assert.Equal(t, prior.Params, state.Params)
+}
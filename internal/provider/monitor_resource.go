@@ -3,32 +3,82 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &MonitorResource{}
-	_ resource.ResourceWithConfigure   = &MonitorResource{}
-	_ resource.ResourceWithImportState = &MonitorResource{}
+	_ resource.Resource                     = &MonitorResource{}
+	_ resource.ResourceWithConfigure        = &MonitorResource{}
+	_ resource.ResourceWithImportState      = &MonitorResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorResource{}
+	_ resource.ResourceWithValidateConfig   = &MonitorResource{}
 )
 
+// Allowed values for monitor enums, mirroring the OpenAPI specification.
+var (
+	monitorNullsModes = []string{
+		string(generated.MetricMonitorParamsNullsModeAllow),
+		string(generated.MetricMonitorParamsNullsModeForbid),
+		string(generated.MetricMonitorParamsNullsModeConvert),
+	}
+	monitorTrendAggFuncs = []string{"avg", "sum", "min", "max", "p50", "p90", "p95", "p99"}
+	monitorTypes         = []string{
+		string(generated.MonitorTypeMetric),
+		string(generated.MonitorTypeError),
+		string(generated.MonitorTypeSpan),
+		string(generated.MonitorTypeLog),
+		string(generated.MonitorTypeUptime),
+	}
+	monitorUptimeMethods = []string{
+		string(generated.UptimeMonitorParamsMethodGET),
+		string(generated.UptimeMonitorParamsMethodHEAD),
+		string(generated.UptimeMonitorParamsMethodPOST),
+		string(generated.UptimeMonitorParamsMethodPUT),
+		string(generated.UptimeMonitorParamsMethodPATCH),
+		string(generated.UptimeMonitorParamsMethodDELETE),
+		string(generated.UptimeMonitorParamsMethodOPTIONS),
+	}
+)
+
+// monitorParamsAttributes maps the typed params attributes to the monitor type they configure.
+var monitorParamsAttributes = map[string]generated.MonitorType{
+	"metric_params": generated.MonitorTypeMetric,
+	"error_params":  generated.MonitorTypeError,
+	"span_params":   generated.MonitorTypeSpan,
+	"log_params":    generated.MonitorTypeLog,
+	"uptime_params": generated.MonitorTypeUptime,
+}
+
+// monitorParamsExactlyOneOf is the note shared by the descriptions of all params attributes.
+const monitorParamsExactlyOneOf = "Exactly one of params, metric_params, error_params, span_params, log_params or uptime_params must be set."
+
 // NewMonitorResource is a helper function to create the resource.
 func NewMonitorResource() resource.Resource {
 	return &MonitorResource{}
@@ -42,15 +92,22 @@ type MonitorResource struct {
 // MonitorResourceModel describes the resource data model.
 type MonitorResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
+	ProjectID             types.Int64  `tfsdk:"project_id"`
 	Name                  types.String `tfsdk:"name"`
 	Type                  types.String `tfsdk:"type"`
 	State                 types.String `tfsdk:"state"`
+	Paused                types.Bool   `tfsdk:"paused"`
 	NotifyEveryoneByEmail types.Bool   `tfsdk:"notify_everyone_by_email"`
 	TeamIDs               types.List   `tfsdk:"team_ids"`
 	ChannelIDs            types.List   `tfsdk:"channel_ids"`
 	RepeatInterval        types.Object `tfsdk:"repeat_interval"`
 	TrendAggFunc          types.String `tfsdk:"trend_agg_func"`
 	Params                types.Object `tfsdk:"params"`
+	MetricParams          types.Object `tfsdk:"metric_params"`
+	ErrorParams           types.Object `tfsdk:"error_params"`
+	SpanParams            types.Object `tfsdk:"span_params"`
+	LogParams             types.Object `tfsdk:"log_params"`
+	UptimeParams          types.Object `tfsdk:"uptime_params"`
 	CreatedAt             types.String `tfsdk:"created_at"`
 	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
@@ -63,7 +120,7 @@ func (r *MonitorResource) Metadata(_ context.Context, req resource.MetadataReque
 // Schema defines the schema for the resource.
 func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an Uptrace monitor for metrics or errors.",
+		Description: "Manages an Uptrace monitor for metrics, errors, spans, logs or uptime checks.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Monitor identifier.",
@@ -72,23 +129,36 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": projectIDResourceAttribute("monitor"),
 			"name": schema.StringAttribute{
 				Description: "Monitor name.",
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Monitor type. Must be 'metric' or 'error'.",
+				Description: "Monitor type. Must be 'metric', 'error', 'span', 'log' or 'uptime'.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf("metric", "error"),
+					stringvalidator.OneOf(monitorTypes...),
 				},
 			},
 			"state": schema.StringAttribute{
-				Description: "Current monitor state (open, firing, paused).",
-				Computed:    true,
+				Description: "Monitor state (open, firing, paused). Refreshing only tracks whether the monitor is paused, " +
+					"so the state doesn't change when the monitor starts or stops firing.",
+				Computed: true,
+			},
+			"paused": schema.BoolAttribute{
+				Description: "Whether the monitor is paused. A paused monitor is not evaluated and sends no notifications. " +
+					"When omitted, the current paused status is left unchanged. Omit it on monitors selected by " +
+					"an uptrace_maintenance_window, which pauses and activates them: paused = false activates them " +
+					"during the window, and the window's pause shows as a change to paused.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"notify_everyone_by_email": schema.BoolAttribute{
 				Description: "Whether to notify all project members by email.",
@@ -124,11 +194,13 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							stringvalidator.OneOf("default", "custom"),
 						},
 					},
-					"interval": schema.Int64Attribute{
-						Description: "Custom interval in seconds (only for custom strategy, minimum 60).",
-						Optional:    true,
-						Validators: []validator.Int64{
-							int64validator.AtLeast(60),
+					"interval": schema.StringAttribute{
+						Description: "Custom interval as a duration such as \"30m\" or \"1h\", or a number of seconds " +
+							"(only for custom strategy, minimum 1m).",
+						CustomType: durationSecondsType,
+						Optional:   true,
+						Validators: []validator.String{
+							durationAtLeast(time.Second, time.Minute),
 						},
 					},
 				},
@@ -139,10 +211,15 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					"Valid values: avg, sum, min, max, p50, p90, p95, p99.",
 				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(monitorTrendAggFuncs...),
+				},
 			},
 			"params": schema.SingleNestedAttribute{
-				Description: "Monitor parameters (metric or error specific).",
-				Required:    true,
+				Description: "Monitor parameters (metric or error specific). Deprecated: use metric_params or error_params, " +
+					"which validate the parameters of each monitor type. " + monitorParamsExactlyOneOf,
+				DeprecationMessage: "Use metric_params for metric monitors or error_params for error monitors instead.",
+				Optional:           true,
 				Attributes: map[string]schema.Attribute{
 					// Metric monitor params
 					"metrics": schema.ListNestedAttribute{
@@ -162,10 +239,14 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						},
 					},
 					"query": schema.StringAttribute{
-						Description: "UQL query for metric evaluation or error filtering. " +
-							"Note: The Uptrace cloud API normalizes queries to canonical form, so the stored value may differ from the input.",
-						Optional: true,
-						Computed: true,
+						Description: "UQL query for metric evaluation or error filtering. Every metric alias it references must be declared in metrics. " +
+							"Queries that only differ in whitespace, quoting or keyword case are considered equal.",
+						CustomType: UQLQueryType{},
+						Optional:   true,
+						Computed:   true,
+						Validators: []validator.String{
+							uqlQueryValidator{},
+						},
 					},
 					"column": schema.StringAttribute{
 						Description: "Column name to evaluate (metric monitors only).",
@@ -180,8 +261,9 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Description: "Maximum allowed value for the metric.",
 						Optional:    true,
 					},
-					"grouping_interval": schema.Float64Attribute{
-						Description: "Grouping interval in milliseconds.",
+					"grouping_interval": schema.StringAttribute{
+						Description: "Grouping interval as a duration such as \"5m\", or a number of milliseconds.",
+						CustomType:  durationMillisecondsType,
 						Optional:    true,
 						Computed:    true,
 					},
@@ -194,9 +276,190 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Description: "How to handle null values: 'allow', 'forbid', or 'convert'.",
 						Optional:    true,
 						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(monitorNullsModes...),
+						},
 					},
-					"time_offset": schema.Float64Attribute{
-						Description: "Time offset in milliseconds.",
+					"time_offset": schema.StringAttribute{
+						Description: "Time offset as a duration such as \"5m\", or a number of milliseconds.",
+						CustomType:  durationMillisecondsType,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+			"metric_params": schema.SingleNestedAttribute{
+				Description: "Parameters of a metric monitor. Requires type = \"metric\". " + monitorParamsExactlyOneOf,
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"metrics": monitorMetricsAttribute("Metrics used in the query, referenced by their alias."),
+					"query": schema.StringAttribute{
+						Description: "UQL query for metric evaluation. Every metric alias it references must be declared in metrics. " +
+							"Queries that only differ in whitespace, quoting or keyword case are considered equal.",
+						CustomType: UQLQueryType{},
+						Required:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							uqlQueryValidator{},
+						},
+					},
+					"column": schema.StringAttribute{
+						Description: "Column of the query result to evaluate.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"condition": schema.SingleNestedAttribute{
+						Description: "When the monitor alerts, as an alternative to min_allowed_value and max_allowed_value. " +
+							"Uptrace only alerts when the value leaves an allowed range, so there is no operator alerting inside a range, " +
+							"and no separate recovery threshold: the monitor recovers once the value is back in the range.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								Description: "Alert when the value is above value, below value, or outside the range from lower to upper.",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(metricConditionOperators...),
+								},
+							},
+							"value": schema.Float64Attribute{
+								Description: "Threshold of the above and below operators.",
+								Optional:    true,
+							},
+							"lower": schema.Float64Attribute{
+								Description: "Lower bound of the outside operator.",
+								Optional:    true,
+							},
+							"upper": schema.Float64Attribute{
+								Description: "Upper bound of the outside operator.",
+								Optional:    true,
+							},
+							"for_points": schema.Int64Attribute{
+								Description: "Number of consecutive points that must meet the condition before the monitor alerts. " +
+									"Sets check_num_point, which conflicts with it.",
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.ConflictsWith(path.MatchRelative().AtParent().AtParent().AtName("check_num_point")),
+								},
+							},
+						},
+						Validators: []validator.Object{
+							objectvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("min_allowed_value"),
+								path.MatchRelative().AtParent().AtName("max_allowed_value"),
+							),
+						},
+					},
+					"min_allowed_value": schema.Float64Attribute{
+						Description: "Minimum allowed value for the metric. Conflicts with condition.",
+						Optional:    true,
+					},
+					"max_allowed_value": schema.Float64Attribute{
+						Description: "Maximum allowed value for the metric. Conflicts with condition.",
+						Optional:    true,
+					},
+					"grouping_interval": schema.StringAttribute{
+						Description: "Grouping interval as a duration such as \"5m\", or a number of milliseconds. Defaults to 1m.",
+						CustomType:  durationMillisecondsType,
+						Optional:    true,
+						Computed:    true,
+					},
+					"check_num_point": schema.Int64Attribute{
+						Description: "Number of consecutive points that must breach threshold. Defaults to 1. Conflicts with condition.for_points.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"nulls_mode": schema.StringAttribute{
+						Description: "How to handle null values: 'allow', 'forbid', or 'convert'. Defaults to 'allow'.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(monitorNullsModes...),
+						},
+					},
+					"time_offset": schema.StringAttribute{
+						Description: "Time offset as a duration such as \"5m\", or a number of milliseconds. Defaults to 0s.",
+						CustomType:  durationMillisecondsType,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+			"error_params": schema.SingleNestedAttribute{
+				Description: "Parameters of an error monitor. Requires type = \"error\". " + monitorParamsExactlyOneOf,
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"metrics": monitorMetricsAttribute("Error metrics to monitor."),
+					"query": schema.StringAttribute{
+						Description: "Optional UQL filter for errors. " +
+							"Queries that only differ in whitespace, quoting or keyword case are considered equal.",
+						CustomType: UQLQueryType{},
+						Optional:   true,
+					},
+				},
+			},
+			"span_params": monitorEventParamsAttribute("span", "spans"),
+			"log_params":  monitorEventParamsAttribute("log", "logs"),
+			"uptime_params": schema.SingleNestedAttribute{
+				Description: "Parameters of an uptime monitor, which periodically sends an HTTP request to a URL. " +
+					"Requires type = \"uptime\". " + monitorParamsExactlyOneOf,
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "HTTP or HTTPS URL to check.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://\S+$`), "must be an HTTP or HTTPS URL"),
+						},
+					},
+					"method": schema.StringAttribute{
+						Description: "HTTP request method. Defaults to 'GET'.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(monitorUptimeMethods...),
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "HTTP request headers.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"body": schema.StringAttribute{
+						Description: "HTTP request body.",
+						Optional:    true,
+					},
+					"expected_status_codes": schema.ListAttribute{
+						Description: "Status codes of a successful check. Defaults to [200].",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+						},
+					},
+					"check_interval": schema.Int64Attribute{
+						Description: "Interval between checks in seconds (minimum 30). Defaults to 60.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(30),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						Description: "Request timeout in seconds. Defaults to 10.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"follow_redirects": schema.BoolAttribute{
+						Description: "Whether to follow redirects. Defaults to true.",
 						Optional:    true,
 						Computed:    true,
 					},
@@ -214,6 +477,134 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// monitorMetricsAttribute returns the schema of the metrics list of metric_params and error_params.
+func monitorMetricsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Metric name.",
+					Required:    true,
+				},
+				"alias": schema.StringAttribute{
+					Description: "Optional alias for the metric, such as $cpu.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// monitorEventParamsAttribute returns the schema of span_params and log_params,
+// which evaluate a UQL query over spans or logs instead of metrics.
+func monitorEventParamsAttribute(monitorType, events string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Parameters of a %s monitor, which evaluates a UQL query over %s. Requires type = %q. ",
+			monitorType, events, monitorType) + monitorParamsExactlyOneOf,
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: fmt.Sprintf("UQL query aggregating the matching %s, such as \"count() | where service_name = 'api'\". ", events) +
+					"It can't reference metric aliases. " +
+					"Queries that only differ in whitespace, quoting or keyword case are considered equal.",
+				CustomType: UQLQueryType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					uqlQueryValidator{withoutMetrics: true},
+				},
+			},
+			"column": schema.StringAttribute{
+				Description: "Column of the query result to evaluate.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min_allowed_value": schema.Float64Attribute{
+				Description: "Minimum allowed value for the column.",
+				Optional:    true,
+			},
+			"max_allowed_value": schema.Float64Attribute{
+				Description: "Maximum allowed value for the column.",
+				Optional:    true,
+			},
+			"grouping_interval": schema.StringAttribute{
+				Description: "Grouping interval as a duration such as \"5m\", or a number of milliseconds. Defaults to 1m.",
+				CustomType:  durationMillisecondsType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"check_num_point": schema.Int64Attribute{
+				Description: "Number of consecutive points that must breach threshold. Defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the resource-level validators.
+func (r *MonitorResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("params"),
+			path.MatchRoot("metric_params"),
+			path.MatchRoot("error_params"),
+			path.MatchRoot("span_params"),
+			path.MatchRoot("log_params"),
+			path.MatchRoot("uptime_params"),
+		),
+		monitorRepeatIntervalValidator{},
+		monitorThresholdsValidator{},
+	}
+}
+
+// ValidateConfig checks that the configured typed params attribute matches the monitor type.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsNull() || monitorType.IsUnknown() {
+		return
+	}
+
+	for attribute, attributeType := range monitorParamsAttributes {
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.IsNull() || string(attributeType) == monitorType.ValueString() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Monitor Params Do Not Match Type",
+			fmt.Sprintf("The %s attribute can only be used with type = %q, got %q.", attribute, attributeType, monitorType.ValueString()),
+		)
+	}
+
+	// The deprecated params attribute predates the other monitor types.
+	var params types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params"), &params)...)
+	switch generated.MonitorType(monitorType.ValueString()) {
+	case generated.MonitorTypeMetric, generated.MonitorTypeError:
+	default:
+		if !params.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("params"),
+				"Monitor Params Do Not Match Type",
+				fmt.Sprintf("The deprecated params attribute only supports metric and error monitors, got %q. Use %s_params instead.",
+					monitorType.ValueString(), monitorType.ValueString()),
+			)
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *MonitorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -251,7 +642,9 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create monitor via API
-	monitor, err := r.client.CreateMonitor(ctx, input)
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+	apiClient := clientForProject(r.client, plan.ProjectID)
+	monitor, err := apiClient.CreateMonitor(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Monitor",
@@ -260,8 +653,17 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// Apply the configured paused status
+	r.reconcilePaused(ctx, apiClient, monitor, plan.Paused, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Keep the created monitor in state so it is not orphaned
+		monitorToResourceState(ctx, monitor, plan, &plan, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Convert API response to state
-	monitorToState(ctx, monitor, &plan, &resp.Diagnostics)
+	monitorToResourceState(ctx, monitor, plan, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -284,7 +686,8 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Info(ctx, "Reading monitor", map[string]any{"id": state.ID.ValueString()})
 
 	// Get monitor from API
-	monitor, err := r.client.GetMonitor(ctx, state.ID.ValueString())
+	state.ProjectID = resolvedProjectID(r.client, state.ProjectID)
+	monitor, err := clientForProject(r.client, state.ProjectID).GetMonitor(ctx, state.ID.ValueString())
 	if err != nil {
 		// If the monitor doesn't exist (404), remove from state
 		if isNotFoundError(err) {
@@ -301,11 +704,17 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Convert API response to state
-	monitorToState(ctx, monitor, &state, &resp.Diagnostics)
+	priorState := state.State
+	monitorToResourceState(ctx, monitor, state, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Monitors switch between open and firing on their own, which is not a change to the configuration
+	if isAlertingState(priorState) && isAlertingState(state.State) {
+		state.State = priorState
+	}
+
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -329,7 +738,8 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update monitor via API
-	monitor, err := r.client.UpdateMonitor(ctx, plan.ID.ValueString(), input)
+	apiClient := clientForProject(r.client, plan.ProjectID)
+	monitor, err := apiClient.UpdateMonitor(ctx, plan.ID.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Monitor",
@@ -338,8 +748,14 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Apply the configured paused status
+	r.reconcilePaused(ctx, apiClient, monitor, plan.Paused, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert API response to state
-	monitorToState(ctx, monitor, &plan, &resp.Diagnostics)
+	monitorToResourceState(ctx, monitor, plan, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -362,7 +778,7 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Info(ctx, "Deleting monitor", map[string]any{"id": state.ID.ValueString()})
 
 	// Delete monitor via API
-	err := r.client.DeleteMonitor(ctx, state.ID.ValueString())
+	err := clientForProject(r.client, state.ProjectID).DeleteMonitor(ctx, state.ID.ValueString())
 	if err != nil {
 		// If the monitor doesn't exist (404), treat as already deleted
 		if isNotFoundError(err) {
@@ -380,7 +796,239 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Info(ctx, "Deleted monitor", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports an existing resource into Terraform.
+// reconcilePaused pauses or activates the monitor when the planned value differs from the API.
+// On success the monitor's State field is updated to reflect the new status.
+func (r *MonitorResource) reconcilePaused(ctx context.Context, apiClient *client.Client, monitor *generated.Monitor, planned types.Bool, diags *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	want := planned.ValueBool()
+	current := monitor.State == generated.MonitorStatePaused
+	if want == current {
+		return
+	}
+
+	tflog.Debug(ctx, "Reconciling monitor paused status", map[string]any{
+		"id":      monitor.Id,
+		"current": current,
+		"planned": want,
+	})
+
+	monitorID := strconv.FormatInt(monitor.Id, 10)
+	var err error
+	if want {
+		err = apiClient.PauseMonitor(ctx, monitorID)
+	} else {
+		err = apiClient.ActivateMonitor(ctx, monitorID)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Updating Monitor Paused Status",
+			fmt.Sprintf("Could not set paused=%t for monitor ID %d: %s", want, monitor.Id, err.Error()),
+		)
+		return
+	}
+
+	if want {
+		monitor.State = generated.MonitorStatePaused
+	} else {
+		monitor.State = generated.MonitorStateOpen
+	}
+}
+
+// isAlertingState reports whether a monitor state is open or firing, that is, the monitor is not paused.
+func isAlertingState(state types.String) bool {
+	return state.ValueString() == string(generated.MonitorStateOpen) ||
+		state.ValueString() == string(generated.MonitorStateFiring)
+}
+
+// ImportState imports an existing resource using an ID of the form
+// "[<project_id>/](<monitor_id>|name:<monitor_name>)".
 func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, r.client, "monitor", findMonitorIDsByName, req, resp)
+}
+
+// findMonitorIDsByName returns the IDs of all monitors with the given exact name.
+func findMonitorIDsByName(ctx context.Context, apiClient *client.Client, name string) ([]int64, error) {
+	monitors, err := apiClient.ListMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for i := range monitors {
+		if monitors[i].Name == name {
+			ids = append(ids, monitors[i].Id)
+		}
+	}
+	return ids, nil
+}
+
+// monitorRepeatIntervalValidator checks that repeat_interval.interval is only set with the custom strategy.
+type monitorRepeatIntervalValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v monitorRepeatIntervalValidator) Description(_ context.Context) string {
+	return "repeat_interval.interval can only be set when repeat_interval.strategy is \"custom\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v monitorRepeatIntervalValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v monitorRepeatIntervalValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	intervalPath := path.Root("repeat_interval").AtName("interval")
+
+	var interval DurationValue
+	var strategy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, intervalPath, &interval)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repeat_interval").AtName("strategy"), &strategy)...)
+	if resp.Diagnostics.HasError() || interval.IsNull() || strategy.IsUnknown() {
+		return
+	}
+
+	// An omitted strategy defaults to "default".
+	if strategy.ValueString() != string(generated.RepeatIntervalStrategyCustom) {
+		resp.Diagnostics.AddAttributeError(
+			intervalPath,
+			"Invalid Repeat Interval",
+			fmt.Sprintf("The interval can only be set when strategy is %q. Set strategy = %q or remove the interval.",
+				generated.RepeatIntervalStrategyCustom, generated.RepeatIntervalStrategyCustom),
+		)
+	}
+}
+
+// monitorThresholdsValidator checks the allowed value range of metric, span and log monitors:
+// at least one bound or a metric condition must be set and min_allowed_value must not exceed max_allowed_value.
+type monitorThresholdsValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v monitorThresholdsValidator) Description(_ context.Context) string {
+	return "metric, span and log monitors must set min_allowed_value, max_allowed_value or a metric condition, " +
+		"and min_allowed_value must not exceed max_allowed_value"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v monitorThresholdsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v monitorThresholdsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attribute := range []string{"metric_params", "span_params", "log_params", "params"} {
+		var params types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &params)...)
+		if resp.Diagnostics.HasError() || params.IsNull() || params.IsUnknown() {
+			continue
+		}
+
+		var minValue, maxValue types.Float64
+		minPath := path.Root(attribute).AtName("min_allowed_value")
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, minPath, &minValue)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute).AtName("max_allowed_value"), &maxValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The condition replaces the allowed value range of metric_params.
+		if attribute == "metric_params" {
+			var condition types.Object
+			conditionPath := path.Root(attribute).AtName("condition")
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, conditionPath, &condition)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !condition.IsNull() {
+				validateMetricCondition(ctx, conditionPath, condition, &resp.Diagnostics)
+				continue
+			}
+		}
+
+		// The deprecated params attribute is shared with error monitors, which have no thresholds.
+		hasThresholds := attribute != "params" || monitorType.ValueString() == string(generated.MonitorTypeMetric)
+		if hasThresholds && minValue.IsNull() && maxValue.IsNull() {
+			message := "The monitor must set min_allowed_value, max_allowed_value or both, otherwise it can never fire."
+			if attribute == "metric_params" {
+				message = "The monitor must set condition, min_allowed_value, max_allowed_value or both, " +
+					"otherwise it can never fire."
+			}
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Missing Monitor Threshold", message)
+		}
+
+		if isKnownFloat64(minValue) && isKnownFloat64(maxValue) && minValue.ValueFloat64() > maxValue.ValueFloat64() {
+			resp.Diagnostics.AddAttributeError(
+				minPath,
+				"Invalid Monitor Threshold",
+				fmt.Sprintf("min_allowed_value (%g) must not be greater than max_allowed_value (%g).",
+					minValue.ValueFloat64(), maxValue.ValueFloat64()),
+			)
+		}
+	}
+}
+
+// validateMetricCondition checks that a condition sets the thresholds its operator uses, and only those.
+// The outside operator needs lower not to exceed upper, like min_allowed_value and max_allowed_value.
+func validateMetricCondition(ctx context.Context, conditionPath path.Path, condition types.Object, diags *diag.Diagnostics) {
+	if condition.IsUnknown() {
+		return
+	}
+
+	var model MetricConditionModel
+	diags.Append(condition.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || model.Operator.IsUnknown() {
+		return
+	}
+
+	operator := model.Operator.ValueString()
+	required, conflicting := []string{"value"}, []string{"lower", "upper"}
+	if operator == metricConditionOutside {
+		required, conflicting = conflicting, required
+	}
+
+	attrs := condition.Attributes()
+	for _, name := range required {
+		if attrs[name].IsNull() {
+			diags.AddAttributeError(
+				conditionPath.AtName(name),
+				"Missing Monitor Condition Threshold",
+				fmt.Sprintf("The %s operator requires %s.", operator, name),
+			)
+		}
+	}
+	for _, name := range conflicting {
+		if !attrs[name].IsNull() {
+			diags.AddAttributeError(
+				conditionPath.AtName(name),
+				"Invalid Monitor Condition Threshold",
+				fmt.Sprintf("The %s operator doesn't use %s. Remove it from the condition.", operator, name),
+			)
+		}
+	}
+
+	if operator == metricConditionOutside && isKnownFloat64(model.Lower) && isKnownFloat64(model.Upper) &&
+		model.Lower.ValueFloat64() > model.Upper.ValueFloat64() {
+		diags.AddAttributeError(
+			conditionPath.AtName("lower"),
+			"Invalid Monitor Condition Threshold",
+			fmt.Sprintf("lower (%g) must not be greater than upper (%g).", model.Lower.ValueFloat64(), model.Upper.ValueFloat64()),
+		)
+	}
+}
+
+// isKnownFloat64 reports whether a value is neither null nor unknown.
+func isKnownFloat64(value types.Float64) bool {
+	return !value.IsNull() && !value.IsUnknown()
 }
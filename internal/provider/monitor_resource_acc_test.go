@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
 )
@@ -39,9 +42,10 @@ func TestAccMonitorResource_MetricBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "type", "metric"),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "notify_everyone_by_email", "false"),
-					resource.TestCheckResourceAttr(resourceName, "params.metrics.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "params.metrics.0.name", "system.cpu.utilization"),
-					resource.TestCheckResourceAttr(resourceName, "params.query", "avg($cpu) > 80"),
+					resource.TestCheckResourceAttr(resourceName, "metric_params.metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_params.metrics.0.name", "system.cpu.utilization"),
+					resource.TestCheckResourceAttr(resourceName, "metric_params.query", "avg($cpu) > 80"),
+					resource.TestCheckNoResourceAttr(resourceName, "params"),
 				),
 			},
 			// ImportState testing
@@ -50,6 +54,13 @@ func TestAccMonitorResource_MetricBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by name
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + monitorName,
+				ImportStateVerify: true,
+			},
 			// Update testing
 			{
 				Config: testAccMonitorResourceConfigMetricBasic(monitorName + "-updated"),
@@ -78,7 +89,43 @@ func TestAccMonitorResource_ErrorBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", monitorName),
 					resource.TestCheckResourceAttr(resourceName, "type", "error"),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "error_params.metrics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccMonitorResource_DeprecatedParams verifies that monitors using the deprecated params
+// attribute keep working and can move to the typed attribute.
+func TestAccMonitorResource_DeprecatedParams(t *testing.T) {
+	resourceName := "uptrace_monitor.test"
+	monitorName := acceptancetests.RandomTestName("tf-acc-params")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorResourceConfigDeprecatedParams(monitorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "params.metrics.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "error_params"),
+				),
+			},
+			// Moving to error_params updates the monitor in place
+			{
+				Config: testAccMonitorResourceConfigErrorBasic(monitorName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "error_params.metrics.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "params"),
 				),
 			},
 		},
@@ -145,6 +192,84 @@ func TestAccMonitorResource_CloudTrendAggregation(t *testing.T) {
 
 // Helper functions
 
+func TestAccMonitorResource_ProjectID(t *testing.T) {
+	resourceName := "uptrace_monitor.test"
+	monitorName := acceptancetests.RandomTestName("tf-acc-project")
+	projectID := strconv.Itoa(acceptancetests.GetTestProjectID())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorResourceConfigProjectID(monitorName, projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+				),
+			},
+			// Import with a <project_id>/<monitor_id> ID
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+					return projectID + "/" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+// TestAccMonitorResource_ImportGeneratedConfig imports a monitor created outside Terraform with an
+// import block and the minimal configuration `terraform plan -generate-config-out` produces for it.
+// The plan must contain no changes.
+func TestAccMonitorResource_ImportGeneratedConfig(t *testing.T) {
+	monitorName := acceptancetests.RandomTestName("tf-acc-genconfig")
+	var monitorID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acceptancetests.GetTestClient()
+					monitor, err := client.CreateMonitor(context.Background(), acceptancetests.GetMetricMonitorInput(monitorName))
+					if err != nil {
+						t.Fatalf("Failed to create monitor: %s", err)
+					}
+					monitorID = strconv.FormatInt(monitor.Id, 10)
+					t.Cleanup(func() {
+						_ = client.DeleteMonitor(context.Background(), monitorID)
+					})
+				},
+				Config:          testAccMonitorResourceConfigGenerated(monitorName),
+				ResourceName:    "uptrace_monitor.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) {
+					return monitorID, nil
+				},
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("uptrace_monitor.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckMonitorExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -207,7 +332,7 @@ resource "uptrace_monitor" "test" {
 
   notify_everyone_by_email = false
 
-  params = {
+  metric_params = {
     metrics = [
       {
         name  = "system.cpu.utilization"
@@ -215,6 +340,55 @@ resource "uptrace_monitor" "test" {
       }
     ]
     query             = "avg($cpu) > 80"
+    column            = "value"
+    max_allowed_value = 80
+    check_num_point   = 2
+  }
+}
+`, acceptancetests.GetTestProviderConfig(), name)
+}
+
+func testAccMonitorResourceConfigProjectID(name, projectID string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_monitor" "test" {
+  project_id = %s
+  name       = "%s"
+  type       = "error"
+
+  error_params = {
+    metrics = [
+      {
+        name  = "uptrace_tracing_events"
+        alias = "$logs"
+      }
+    ]
+    query = "sum($logs) | where span.event_name exists"
+  }
+}
+`, acceptancetests.GetTestProviderConfig(), projectID, name)
+}
+
+// testAccMonitorResourceConfigGenerated returns the configuration generated for a
+// monitor created from acceptancetests.GetMetricMonitorInput.
+func testAccMonitorResourceConfigGenerated(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_monitor" "test" {
+  name = %q
+  type = "metric"
+
+  metric_params = {
+    metrics = [
+      {
+        name  = "system.cpu.utilization"
+        alias = "$cpu"
+      }
+    ]
+    query             = "avg($cpu) > 80"
+    column            = "value"
     max_allowed_value = 80
     check_num_point   = 2
   }
@@ -226,6 +400,29 @@ func testAccMonitorResourceConfigErrorBasic(name string) string {
 	return fmt.Sprintf(`
 %s
 
+resource "uptrace_monitor" "test" {
+  name = "%s"
+  type = "error"
+
+  notify_everyone_by_email = false
+
+  error_params = {
+    metrics = [
+      {
+        name  = "uptrace_tracing_events"
+        alias = "$logs"
+      }
+    ]
+    query = "sum($logs) | where span.event_name exists"
+  }
+}
+`, acceptancetests.GetTestProviderConfig(), name)
+}
+
+func testAccMonitorResourceConfigDeprecatedParams(name string) string {
+	return fmt.Sprintf(`
+%s
+
 resource "uptrace_monitor" "test" {
   name = "%s"
   type = "error"
@@ -255,7 +452,7 @@ resource "uptrace_monitor" "test" {
 
   trend_agg_func = "sum"
 
-  params = {
+  error_params = {
     metrics = [{
       name = "uptrace_tracing_events"
       alias = "$events"
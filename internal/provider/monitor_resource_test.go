@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// newMonitorConfig returns a monitor configuration with the given attributes set.
+func newMonitorConfig(t *testing.T, attrs map[string]attr.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// Build the configuration through a state, which can be written attribute by attribute
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attrs {
+		diags := state.SetAttribute(ctx, path.Root(name), value)
+		require.False(t, diags.HasError(), "Failed to set %s: %v", name, diags)
+	}
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestMonitorResourceValidateConfig(t *testing.T) {
+	high := 90.0
+	errorParams := types.ObjectValueMust(errorParamsAttrTypes, map[string]attr.Value{
+		"metrics": testMetricsList("uptrace_tracing_events", "$logs"),
+		"query":   NewUQLQueryNull(),
+	})
+
+	tests := []struct {
+		name      string
+		attrs     map[string]attr.Value
+		wantError bool
+	}{
+		{
+			name:  "matching type",
+			attrs: map[string]attr.Value{"type": types.StringValue("error"), "error_params": errorParams},
+		},
+		{
+			name:      "mismatched type",
+			attrs:     map[string]attr.Value{"type": types.StringValue("metric"), "error_params": errorParams},
+			wantError: true,
+		},
+		{
+			name:  "unknown type",
+			attrs: map[string]attr.Value{"type": types.StringUnknown(), "error_params": errorParams},
+		},
+		{
+			name:  "matching span type",
+			attrs: map[string]attr.Value{"type": types.StringValue("span"), "span_params": testEventParams(nil, &high)},
+		},
+		{
+			name:      "span params with log type",
+			attrs:     map[string]attr.Value{"type": types.StringValue("log"), "span_params": testEventParams(nil, &high)},
+			wantError: true,
+		},
+		{
+			name:      "deprecated params with span type",
+			attrs:     map[string]attr.Value{"type": types.StringValue("span"), "params": testLegacyParams(nil, &high)},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: newMonitorConfig(t, tt.attrs)}
+			resp := &resource.ValidateConfigResponse{}
+
+			(&MonitorResource{}).ValidateConfig(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// testMetricParams returns metric_params with the given thresholds; nil bounds are null.
+func testMetricParams(minValue, maxValue *float64) types.Object {
+	return types.ObjectValueMust(metricParamsAttrTypes, map[string]attr.Value{
+		"metrics":           testMetricsList("system_cpu_utilization", "$cpu"),
+		"query":             NewUQLQueryValue("avg($cpu)"),
+		"column":            types.StringValue("avg($cpu)"),
+		"condition":         types.ObjectNull(metricConditionAttrTypes),
+		"min_allowed_value": types.Float64PointerValue(minValue),
+		"max_allowed_value": types.Float64PointerValue(maxValue),
+		"grouping_interval": NewDurationNull(time.Millisecond),
+		"check_num_point":   types.Int64Null(),
+		"nulls_mode":        types.StringNull(),
+		"time_offset":       NewDurationNull(time.Millisecond),
+	})
+}
+
+// testLegacyParams returns the deprecated params with the given thresholds; nil bounds are null.
+func testLegacyParams(minValue, maxValue *float64) types.Object {
+	return types.ObjectValueMust(monitorParamsAttrTypes, selectAttrs(testMetricParams(minValue, maxValue).Attributes(), monitorParamsAttrTypes))
+}
+
+// testConditionParams returns metric_params with a condition in place of min and max; nil thresholds are null.
+func testConditionParams(operator string, value, lower, upper *float64) types.Object {
+	attrs := testMetricParams(nil, nil).Attributes()
+	attrs["condition"] = types.ObjectValueMust(metricConditionAttrTypes, map[string]attr.Value{
+		"operator":   types.StringValue(operator),
+		"value":      types.Float64PointerValue(value),
+		"lower":      types.Float64PointerValue(lower),
+		"upper":      types.Float64PointerValue(upper),
+		"for_points": types.Int64Null(),
+	})
+	return types.ObjectValueMust(metricParamsAttrTypes, attrs)
+}
+
+// testEventParams returns span_params or log_params with the given thresholds; nil bounds are null.
+func testEventParams(minValue, maxValue *float64) types.Object {
+	return types.ObjectValueMust(spanParamsAttrTypes, map[string]attr.Value{
+		"query":             NewUQLQueryValue("count() | where _status_code = 'error'"),
+		"column":            types.StringValue("count()"),
+		"min_allowed_value": types.Float64PointerValue(minValue),
+		"max_allowed_value": types.Float64PointerValue(maxValue),
+		"grouping_interval": NewDurationNull(time.Millisecond),
+		"check_num_point":   types.Int64Null(),
+	})
+}
+
+func TestMonitorResourceConfigValidators(t *testing.T) {
+	low, high := 10.0, 90.0
+	repeatInterval := func(strategy types.String, interval DurationValue) types.Object {
+		return types.ObjectValueMust(repeatIntervalAttrTypes, map[string]attr.Value{
+			"strategy": strategy,
+			"interval": interval,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		attrs     map[string]attr.Value
+		wantPaths []path.Path
+	}{
+		{
+			name: "valid metric monitor",
+			attrs: map[string]attr.Value{
+				"type":            types.StringValue("metric"),
+				"metric_params":   testMetricParams(&low, &high),
+				"repeat_interval": repeatInterval(types.StringValue("custom"), NewDurationValue("5m", time.Second)),
+			},
+		},
+		{
+			name: "interval with default strategy",
+			attrs: map[string]attr.Value{
+				"type":            types.StringValue("metric"),
+				"metric_params":   testMetricParams(nil, &high),
+				"repeat_interval": repeatInterval(types.StringValue("default"), NewDurationValue("5m", time.Second)),
+			},
+			wantPaths: []path.Path{path.Root("repeat_interval").AtName("interval")},
+		},
+		{
+			name: "interval with omitted strategy",
+			attrs: map[string]attr.Value{
+				"type":            types.StringValue("metric"),
+				"metric_params":   testMetricParams(nil, &high),
+				"repeat_interval": repeatInterval(types.StringNull(), NewDurationValue("5m", time.Second)),
+			},
+			wantPaths: []path.Path{path.Root("repeat_interval").AtName("interval")},
+		},
+		{
+			name: "interval with unknown strategy",
+			attrs: map[string]attr.Value{
+				"type":            types.StringValue("metric"),
+				"metric_params":   testMetricParams(nil, &high),
+				"repeat_interval": repeatInterval(types.StringUnknown(), NewDurationValue("5m", time.Second)),
+			},
+		},
+		{
+			name: "min greater than max",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testMetricParams(&high, &low),
+			},
+			wantPaths: []path.Path{path.Root("metric_params").AtName("min_allowed_value")},
+		},
+		{
+			name: "no thresholds",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testMetricParams(nil, nil),
+			},
+			wantPaths: []path.Path{path.Root("metric_params")},
+		},
+		{
+			name: "condition",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testConditionParams("above", &high, nil, nil),
+			},
+		},
+		{
+			name: "condition without value",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testConditionParams("below", nil, nil, nil),
+			},
+			wantPaths: []path.Path{path.Root("metric_params").AtName("condition").AtName("value")},
+		},
+		{
+			name: "outside condition with value",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testConditionParams("outside", &high, &low, &high),
+			},
+			wantPaths: []path.Path{path.Root("metric_params").AtName("condition").AtName("value")},
+		},
+		{
+			name: "outside condition lower greater than upper",
+			attrs: map[string]attr.Value{
+				"type":          types.StringValue("metric"),
+				"metric_params": testConditionParams("outside", nil, &high, &low),
+			},
+			wantPaths: []path.Path{path.Root("metric_params").AtName("condition").AtName("lower")},
+		},
+		{
+			name: "log monitor without thresholds",
+			attrs: map[string]attr.Value{
+				"type":       types.StringValue("log"),
+				"log_params": testEventParams(nil, nil),
+			},
+			wantPaths: []path.Path{path.Root("log_params")},
+		},
+		{
+			name: "span monitor min greater than max",
+			attrs: map[string]attr.Value{
+				"type":        types.StringValue("span"),
+				"span_params": testEventParams(&high, &low),
+			},
+			wantPaths: []path.Path{path.Root("span_params").AtName("min_allowed_value")},
+		},
+		{
+			name: "unknown threshold",
+			attrs: map[string]attr.Value{
+				"type": types.StringValue("metric"),
+				"metric_params": types.ObjectValueMust(metricParamsAttrTypes, func() map[string]attr.Value {
+					attrs := testMetricParams(nil, nil).Attributes()
+					attrs["max_allowed_value"] = types.Float64Unknown()
+					return attrs
+				}()),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			req := resource.ValidateConfigRequest{Config: newMonitorConfig(t, tt.attrs)}
+			resp := &resource.ValidateConfigResponse{}
+
+			for _, v := range (&MonitorResource{}).ConfigValidators(ctx) {
+				v.ValidateResource(ctx, req, resp)
+			}
+
+			var gotPaths []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				require.True(t, ok, "Diagnostic without path: %v", d)
+				gotPaths = append(gotPaths, withPath.Path())
+			}
+			assert.ElementsMatch(t, tt.wantPaths, gotPaths, "Diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestMonitorResourceReconcilePaused(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     generated.MonitorState
+		planned   types.Bool
+		wantPath  string
+		wantState generated.MonitorState
+	}{
+		{name: "pause", state: generated.MonitorStateFiring, planned: types.BoolValue(true),
+			wantPath: "/projects/1/monitors/42/paused", wantState: generated.MonitorStatePaused},
+		{name: "activate", state: generated.MonitorStatePaused, planned: types.BoolValue(false),
+			wantPath: "/projects/1/monitors/42/active", wantState: generated.MonitorStateOpen},
+		{name: "already paused", state: generated.MonitorStatePaused, planned: types.BoolValue(true),
+			wantState: generated.MonitorStatePaused},
+		{name: "already active", state: generated.MonitorStateFiring, planned: types.BoolValue(false),
+			wantState: generated.MonitorStateFiring},
+		{name: "omitted", state: generated.MonitorStatePaused, planned: types.BoolNull(),
+			wantState: generated.MonitorStatePaused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				gotPath = r.URL.Path
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+			require.NoError(t, err)
+
+			monitor := &generated.Monitor{Id: 42, State: tt.state}
+			diags := diag.Diagnostics{}
+			(&MonitorResource{}).reconcilePaused(context.Background(), c, monitor, tt.planned, &diags)
+
+			require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.wantPath, gotPath)
+			assert.Equal(t, tt.wantState, monitor.State)
+		})
+	}
+}
+
+func TestMonitorResourceReconcilePaused_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+
+	monitor := &generated.Monitor{Id: 42, State: generated.MonitorStateOpen}
+	diags := diag.Diagnostics{}
+	(&MonitorResource{}).reconcilePaused(context.Background(), c, monitor, types.BoolValue(true), &diags)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Error Updating Monitor Paused Status", diags[0].Summary())
+	assert.Equal(t, generated.MonitorStateOpen, monitor.State)
+}
+
+func TestMonitorSchemas_UptimeHeadersSensitive(t *testing.T) {
+	ctx := context.Background()
+
+	resourceResp := &resource.SchemaResponse{}
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, resourceResp)
+	attribute, diags := resourceResp.Schema.AttributeAtPath(ctx, path.Root("uptime_params").AtName("headers"))
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.True(t, attribute.IsSensitive(), "uptrace_monitor uptime_params.headers should be sensitive")
+
+	dataSourceResp := &datasource.SchemaResponse{}
+	(&MonitorDataSource{}).Schema(ctx, datasource.SchemaRequest{}, dataSourceResp)
+	attribute, diags = dataSourceResp.Schema.AttributeAtPath(ctx, path.Root("uptime_params").AtName("headers"))
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.True(t, attribute.IsSensitive(), "data.uptrace_monitor uptime_params.headers should be sensitive")
+}
+
+func TestIsAlertingState(t *testing.T) {
+	assert.True(t, isAlertingState(types.StringValue("open")))
+	assert.True(t, isAlertingState(types.StringValue("firing")))
+	assert.False(t, isAlertingState(types.StringValue("paused")))
+	assert.False(t, isAlertingState(types.StringNull()))
+}
@@ -34,10 +34,11 @@ type MonitorsDataSource struct {
 
 // MonitorsDataSourceModel describes the data source data model.
 type MonitorsDataSourceModel struct {
-	Type     types.String `tfsdk:"type"`
-	State    types.String `tfsdk:"state"`
-	Name     types.String `tfsdk:"name"`
-	Monitors types.List   `tfsdk:"monitors"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	Type      types.String `tfsdk:"type"`
+	State     types.String `tfsdk:"state"`
+	Name      types.String `tfsdk:"name"`
+	Monitors  types.List   `tfsdk:"monitors"`
 }
 
 // MonitorModel describes an individual monitor in the list.
@@ -66,8 +67,9 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Fetches a list of Uptrace monitors with optional filtering.",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDDataSourceAttribute(),
 			"type": schema.StringAttribute{
-				Description: "Filter monitors by type (metric or error).",
+				Description: "Filter monitors by type (metric, error, span, log or uptime).",
 				Optional:    true,
 			},
 			"state": schema.StringAttribute{
@@ -93,7 +95,7 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed:    true,
 						},
 						"type": schema.StringAttribute{
-							Description: "Monitor type (metric or error).",
+							Description: "Monitor type (metric, error, span, log or uptime).",
 							Computed:    true,
 						},
 						"state": schema.StringAttribute{
@@ -122,8 +124,9 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 									Description: "Repeat interval strategy (default or custom).",
 									Computed:    true,
 								},
-								"interval": schema.Int64Attribute{
-									Description: "Custom interval in seconds.",
+								"interval": schema.StringAttribute{
+									Description: "Custom interval as a duration such as \"30m\".",
+									CustomType:  durationSecondsType,
 									Computed:    true,
 								},
 							},
@@ -156,6 +159,7 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 								},
 								"query": schema.StringAttribute{
 									Description: "UQL query for metric evaluation or error filtering.",
+									CustomType:  UQLQueryType{},
 									Computed:    true,
 								},
 								"column": schema.StringAttribute{
@@ -170,8 +174,9 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 									Description: "Maximum allowed value for the metric.",
 									Computed:    true,
 								},
-								"grouping_interval": schema.Float64Attribute{
-									Description: "Grouping interval in milliseconds.",
+								"grouping_interval": schema.StringAttribute{
+									Description: "Grouping interval as a duration such as \"5m\".",
+									CustomType:  durationMillisecondsType,
 									Computed:    true,
 								},
 								"check_num_point": schema.Int64Attribute{
@@ -182,8 +187,9 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 									Description: "How to handle null values: allow, forbid, or convert.",
 									Computed:    true,
 								},
-								"time_offset": schema.Float64Attribute{
-									Description: "Time offset in milliseconds.",
+								"time_offset": schema.StringAttribute{
+									Description: "Time offset as a duration such as \"5m\".",
+									CustomType:  durationMillisecondsType,
 									Computed:    true,
 								},
 							},
@@ -238,7 +244,9 @@ func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	})
 
 	// Fetch all monitors from API
-	monitors, err := d.client.ListMonitors(ctx)
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+	monitors, err := apiClient.ListMonitors(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Monitors",
@@ -353,27 +361,11 @@ func convertMonitorModelsToList(_ context.Context, models []MonitorModel) (types
 		"notify_everyone_by_email": types.BoolType,
 		"team_ids":                 types.ListType{ElemType: types.Int64Type},
 		"channel_ids":              types.ListType{ElemType: types.Int64Type},
-		"repeat_interval": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"strategy": types.StringType,
-			"interval": types.Int64Type,
-		}},
-		"trend_agg_func": types.StringType,
-		"params": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"metrics": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"alias": types.StringType,
-			}}},
-			"query":             types.StringType,
-			"column":            types.StringType,
-			"min_allowed_value": types.Float64Type,
-			"max_allowed_value": types.Float64Type,
-			"grouping_interval": types.Float64Type,
-			"check_num_point":   types.Int64Type,
-			"nulls_mode":        types.StringType,
-			"time_offset":       types.Float64Type,
-		}},
-		"created_at": types.StringType,
-		"updated_at": types.StringType,
+		"repeat_interval":          types.ObjectType{AttrTypes: repeatIntervalAttrTypes},
+		"trend_agg_func":           types.StringType,
+		"params":                   types.ObjectType{AttrTypes: monitorParamsAttrTypes},
+		"created_at":               types.StringType,
+		"updated_at":               types.StringType,
 	}
 
 	// Convert each model to an object value
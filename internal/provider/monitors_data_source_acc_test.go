@@ -133,12 +133,13 @@ resource "uptrace_monitor" "metric1" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 80"
+    column            = "avg($cpu)"
     max_allowed_value = 80
   }
 }
@@ -147,12 +148,13 @@ resource "uptrace_monitor" "metric2" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.memory.usage"
       alias = "$mem"
     }]
     query             = "avg($mem) > 90"
+    column            = "avg($mem)"
     max_allowed_value = 90
   }
 }
@@ -175,12 +177,13 @@ resource "uptrace_monitor" "metric1" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 80"
+    column            = "avg($cpu)"
     max_allowed_value = 80
   }
 }
@@ -189,12 +192,13 @@ resource "uptrace_monitor" "metric2" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.memory.usage"
       alias = "$mem"
     }]
     query             = "avg($mem) > 90"
+    column            = "avg($mem)"
     max_allowed_value = 90
   }
 }
@@ -203,7 +207,7 @@ resource "uptrace_monitor" "error1" {
   name = "%s"
   type = "error"
 
-  params = {
+  error_params = {
     metrics = [{
       name  = "uptrace_tracing_events"
       alias = "$logs"
@@ -233,12 +237,13 @@ resource "uptrace_monitor" "cpu" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 80"
+    column            = "avg($cpu)"
     max_allowed_value = 80
   }
 }
@@ -247,12 +252,13 @@ resource "uptrace_monitor" "mem" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.memory.usage"
       alias = "$mem"
     }]
     query             = "avg($mem) > 90"
+    column            = "avg($mem)"
     max_allowed_value = 90
   }
 }
@@ -288,12 +294,13 @@ resource "uptrace_monitor" "metric_cpu" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.cpu.utilization"
       alias = "$cpu"
     }]
     query             = "avg($cpu) > 80"
+    column            = "avg($cpu)"
     max_allowed_value = 80
   }
 }
@@ -302,12 +309,13 @@ resource "uptrace_monitor" "metric_mem" {
   name = "%s"
   type = "metric"
 
-  params = {
+  metric_params = {
     metrics = [{
       name  = "system.memory.usage"
       alias = "$mem"
     }]
     query             = "avg($mem) > 90"
+    column            = "avg($mem)"
     max_allowed_value = 90
   }
 }
@@ -316,7 +324,7 @@ resource "uptrace_monitor" "error_cpu" {
   name = "%s"
   type = "error"
 
-  params = {
+  error_params = {
     metrics = [{
       name  = "uptrace_tracing_events"
       alias = "$logs"
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertMonitorModelsToList(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	model := convertMonitorToModel(ctx, newImportedMetricMonitor(), &diags)
+	require.False(t, diags.HasError())
+
+	list, diags := convertMonitorModelsToList(ctx, []MonitorModel{model})
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Len(t, list.Elements(), 1)
+}
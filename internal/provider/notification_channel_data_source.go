@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &NotificationChannelDataSource{}
+	_ datasource.DataSourceWithConfigure        = &NotificationChannelDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &NotificationChannelDataSource{}
+)
+
+// NewNotificationChannelDataSource is a helper function to create the data source.
+func NewNotificationChannelDataSource() datasource.DataSource {
+	return &NotificationChannelDataSource{}
+}
+
+// NotificationChannelDataSource is the data source implementation.
+type NotificationChannelDataSource struct {
+	client *client.Client
+}
+
+// NotificationChannelDataSourceModel describes the data source data model.
+type NotificationChannelDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.Int64  `tfsdk:"project_id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Condition    types.String `tfsdk:"condition"`
+	Priority     types.List   `tfsdk:"priority"`
+	Params       types.Map    `tfsdk:"params"`
+	Status       types.String `tfsdk:"status"`
+	MonitorIDs   types.List   `tfsdk:"monitor_ids"`
+	MonitorCount types.Int64  `tfsdk:"monitor_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *NotificationChannelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+// Schema defines the schema for the data source.
+func (d *NotificationChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an existing Uptrace notification channel by ID or exact name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Notification channel identifier. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_id": projectIDDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Exact channel name. Exactly one of id or name must be set. " +
+					"The lookup fails if several channels share the name.",
+				Optional: true,
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Channel type (slack, webhook, telegram or mattermost).",
+				Computed:    true,
+			},
+			"condition": schema.StringAttribute{
+				Description: "Condition expression used to filter notifications.",
+				Computed:    true,
+			},
+			"priority": schema.ListAttribute{
+				Description: "Alert priority levels the channel receives.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"params": schema.MapAttribute{
+				Description: "Channel-specific configuration parameters.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Channel delivery status.",
+				Computed:    true,
+			},
+			"monitor_ids": schema.ListAttribute{
+				Description: "IDs of the monitors using this channel.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"monitor_count": schema.Int64Attribute{
+				Description: "Number of monitors using this channel.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the data source-level validators.
+func (d *NotificationChannelDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NotificationChannelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = uptraceClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (d *NotificationChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NotificationChannelDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading notification channel data source", map[string]any{
+		"id":   config.ID.ValueString(),
+		"name": config.Name.ValueString(),
+	})
+
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+
+	var channel *generated.NotificationChannel
+	if !config.ID.IsNull() {
+		channelID, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Invalid Channel ID",
+				fmt.Sprintf("Could not parse channel ID %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		channel, err = apiClient.GetNotificationChannel(ctx, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Notification Channel",
+				fmt.Sprintf("Could not read notification channel ID %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	} else {
+		channel = findChannelByName(ctx, apiClient, config.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Convert API response to state
+	model := convertChannelToModel(ctx, channel, &resp.Diagnostics)
+	config.ID = model.ID
+	config.Name = model.Name
+	config.Type = model.Type
+	config.Condition = model.Condition
+	config.Priority = model.Priority
+	config.Params = model.Params
+	config.Status = model.Status
+	config.MonitorIDs = model.MonitorIDs
+	config.MonitorCount = model.MonitorCount
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Info(ctx, "Successfully read notification channel data source", map[string]any{"id": config.ID.ValueString()})
+}
+
+// findChannelByName returns the single notification channel with the given exact name.
+func findChannelByName(ctx context.Context, apiClient *client.Client, name string, diags *diag.Diagnostics) *generated.NotificationChannel {
+	channels, err := apiClient.ListNotificationChannels(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Notification Channels",
+			fmt.Sprintf("Could not list notification channels: %s", err.Error()),
+		)
+		return nil
+	}
+
+	var matches []generated.NotificationChannel
+	for i := range channels {
+		if channels[i].Name == name {
+			matches = append(matches, channels[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Notification Channel Not Found",
+			fmt.Sprintf("No notification channel named %q exists in the project.", name),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	default:
+		ids := make([]int64, len(matches))
+		for i := range matches {
+			ids[i] = matches[i].Id
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Notification Channel Name",
+			fmt.Sprintf("Found %d notification channels named %q (IDs %v). Look the channel up by id instead.", len(matches), name, ids),
+		)
+		return nil
+	}
+}
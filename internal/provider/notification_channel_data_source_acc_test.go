@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccNotificationChannelDataSource_ByID(t *testing.T) {
+	resourceName := "uptrace_notification_channel.test"
+	dataSourceName := "data.uptrace_notification_channel.test"
+	channelName := acceptancetests.RandomTestName("tf-acc-ds-channel-id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelDataSourceConfig(channelName, "id = uptrace_notification_channel.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "webhook"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "monitor_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelDataSource_ByName(t *testing.T) {
+	resourceName := "uptrace_notification_channel.test"
+	dataSourceName := "data.uptrace_notification_channel.test"
+	channelName := acceptancetests.RandomTestName("tf-acc-ds-channel-name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelDataSourceConfig(channelName, "name = uptrace_notification_channel.test.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", channelName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "uptrace_notification_channel" "test" {
+  name = "tf-acc-nonexistent-channel-xyz-12345"
+}
+`, acceptancetests.GetTestProviderConfig()),
+				ExpectError: regexp.MustCompile(`Notification Channel Not Found`),
+			},
+		},
+	})
+}
+
+// testAccNotificationChannelDataSourceConfig generates a config that creates a channel and looks it up.
+func testAccNotificationChannelDataSourceConfig(name, lookup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "uptrace_notification_channel" "test" {
+  name = %q
+  type = "webhook"
+
+  params = {
+    url = "https://example.com/webhook"
+  }
+}
+
+data "uptrace_notification_channel" "test" {
+  %s
+}
+`, acceptancetests.GetTestProviderConfig(), name, lookup)
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
@@ -63,8 +68,10 @@ func planToChannelInput(ctx context.Context, plan NotificationChannelResourceMod
 		})
 	}
 
-	// Convert params map to interface{} for JSON marshaling
-	if !plan.Params.IsNull() && !plan.Params.IsUnknown() {
+	// Convert the typed channel block, falling back to the raw params map
+	if params, ok := typedChannelParams(ctx, plan, diags); ok {
+		input.Params = params
+	} else if !plan.Params.IsNull() && !plan.Params.IsUnknown() {
 		paramsMap := make(map[string]string)
 		diags.Append(plan.Params.ElementsAs(ctx, &paramsMap, false)...)
 		if diags.HasError() {
@@ -86,12 +93,11 @@ func planToChannelInput(ctx context.Context, plan NotificationChannelResourceMod
 		input.Params = paramsInterface
 	}
 
-	// Debug: log the marshaled input
-	if jsonBytes, err := json.Marshal(input); err == nil {
-		tflog.Debug(ctx, "Marshaled notification channel input", map[string]any{
-			"json": string(jsonBytes),
-		})
-	}
+	tflog.Debug(ctx, "Built notification channel input", map[string]any{
+		"name":   input.Name,
+		"type":   string(input.Type),
+		"params": redactChannelParams(input.Params),
+	})
 
 	return input
 }
@@ -160,3 +166,299 @@ func channelToState(ctx context.Context, channel *generated.NotificationChannel,
 	state.CreatedAt = types.StringNull()
 	state.UpdatedAt = types.StringNull()
 }
+
+// SlackChannelModel describes the slack channel block.
+type SlackChannelModel struct {
+	WebhookURL   types.String `tfsdk:"webhook_url"`
+	WebhookURLWO types.String `tfsdk:"webhook_url_wo"`
+}
+
+// WebhookChannelModel describes the webhook channel block.
+type WebhookChannelModel struct {
+	URL     types.String `tfsdk:"url"`
+	Payload types.String `tfsdk:"payload"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// TelegramChannelModel describes the telegram channel block.
+type TelegramChannelModel struct {
+	BotToken   types.String `tfsdk:"bot_token"`
+	BotTokenWO types.String `tfsdk:"bot_token_wo"`
+	ChatID     types.String `tfsdk:"chat_id"`
+}
+
+// MattermostChannelModel describes the mattermost channel block.
+type MattermostChannelModel struct {
+	WebhookURL   types.String `tfsdk:"webhook_url"`
+	WebhookURLWO types.String `tfsdk:"webhook_url_wo"`
+}
+
+var slackChannelAttrTypes = map[string]attr.Type{
+	"webhook_url":    types.StringType,
+	"webhook_url_wo": types.StringType,
+}
+
+var webhookChannelAttrTypes = map[string]attr.Type{
+	"url":     types.StringType,
+	"payload": types.StringType,
+	"headers": types.MapType{ElemType: types.StringType},
+}
+
+var telegramChannelAttrTypes = map[string]attr.Type{
+	"bot_token":    types.StringType,
+	"bot_token_wo": types.StringType,
+	"chat_id":      types.StringType,
+}
+
+var mattermostChannelAttrTypes = map[string]attr.Type{
+	"webhook_url":    types.StringType,
+	"webhook_url_wo": types.StringType,
+}
+
+// typedChannelParams builds the API params from whichever typed channel block is set.
+// It returns false when no typed block is configured. Write-only secrets are only
+// present when the model comes from the configuration.
+//
+//nolint:gocritic // Plan passed by value to keep function signatures consistent
+func typedChannelParams(ctx context.Context, plan NotificationChannelResourceModel, diags *diag.Diagnostics) (map[string]any, bool) {
+	switch {
+	case isKnownObject(plan.Slack):
+		var slack SlackChannelModel
+		diags.Append(plan.Slack.As(ctx, &slack, basetypes.ObjectAsOptions{})...)
+		return map[string]any{"webhookUrl": secretValue(slack.WebhookURL, slack.WebhookURLWO)}, true
+	case isKnownObject(plan.Mattermost):
+		var mattermost MattermostChannelModel
+		diags.Append(plan.Mattermost.As(ctx, &mattermost, basetypes.ObjectAsOptions{})...)
+		return map[string]any{"webhookUrl": secretValue(mattermost.WebhookURL, mattermost.WebhookURLWO)}, true
+	case isKnownObject(plan.Telegram):
+		var telegram TelegramChannelModel
+		diags.Append(plan.Telegram.As(ctx, &telegram, basetypes.ObjectAsOptions{})...)
+		return map[string]any{
+			"botToken": secretValue(telegram.BotToken, telegram.BotTokenWO),
+			"chatId":   chatIDParam(telegram.ChatID.ValueString()),
+		}, true
+	case isKnownObject(plan.Webhook):
+		var webhook WebhookChannelModel
+		diags.Append(plan.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})...)
+		params := map[string]any{"url": webhook.URL.ValueString()}
+		if !webhook.Payload.IsNull() && !webhook.Payload.IsUnknown() {
+			var payload any
+			if err := json.Unmarshal([]byte(webhook.Payload.ValueString()), &payload); err != nil {
+				diags.AddAttributeError(
+					path.Root("webhook").AtName("payload"),
+					"Invalid Webhook Payload",
+					fmt.Sprintf("Could not parse payload as JSON: %s", err.Error()),
+				)
+			}
+			params["payload"] = payload
+		}
+		if !webhook.Headers.IsNull() && !webhook.Headers.IsUnknown() {
+			headers := make(map[string]string)
+			diags.Append(webhook.Headers.ElementsAs(ctx, &headers, false)...)
+			params["headers"] = headers
+		}
+		return params, true
+	default:
+		return nil, false
+	}
+}
+
+// readTypedChannelBlocks refreshes the typed channel block held in prior state from the API params.
+// Secrets keep their prior value because Uptrace may omit or mask them in responses.
+// Blocks that are not set in prior state stay null.
+//
+//nolint:gocritic // Prior state passed by value to keep function signatures consistent
+func readTypedChannelBlocks(ctx context.Context, channel *generated.NotificationChannel, prior NotificationChannelResourceModel, state *NotificationChannelResourceModel, diags *diag.Diagnostics) {
+	state.Slack = types.ObjectNull(slackChannelAttrTypes)
+	state.Webhook = types.ObjectNull(webhookChannelAttrTypes)
+	state.Telegram = types.ObjectNull(telegramChannelAttrTypes)
+	state.Mattermost = types.ObjectNull(mattermostChannelAttrTypes)
+
+	var d diag.Diagnostics
+	switch {
+	case isKnownObject(prior.Slack):
+		state.Slack = prior.Slack
+	case isKnownObject(prior.Mattermost):
+		state.Mattermost = prior.Mattermost
+	case isKnownObject(prior.Telegram):
+		var telegram TelegramChannelModel
+		diags.Append(prior.Telegram.As(ctx, &telegram, basetypes.ObjectAsOptions{})...)
+		if chatID := paramChatID(channel.Params["chatId"]); !chatID.IsNull() {
+			telegram.ChatID = chatID
+		}
+		state.Telegram, d = types.ObjectValueFrom(ctx, telegramChannelAttrTypes, telegram)
+		diags.Append(d...)
+	case isKnownObject(prior.Webhook):
+		var webhook WebhookChannelModel
+		diags.Append(prior.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})...)
+		if url, ok := channel.Params["url"].(string); ok && url != "" {
+			webhook.URL = types.StringValue(url)
+		}
+		if payload, ok := channel.Params["payload"]; ok {
+			webhook.Payload = payloadToState(payload, webhook.Payload)
+		}
+		state.Webhook, d = types.ObjectValueFrom(ctx, webhookChannelAttrTypes, webhook)
+		diags.Append(d...)
+	}
+}
+
+// isImportedChannel reports whether the prior state holds neither raw params nor a typed block,
+// which only happens right after an import.
+//
+//nolint:gocritic // Prior state passed by value to keep function signatures consistent
+func isImportedChannel(prior NotificationChannelResourceModel) bool {
+	return prior.Params.IsNull() &&
+		!isKnownObject(prior.Slack) && !isKnownObject(prior.Webhook) &&
+		!isKnownObject(prior.Telegram) && !isKnownObject(prior.Mattermost)
+}
+
+// readImportedChannel fills the typed block matching the channel type from the API params,
+// so configuration generated after an import uses the typed block instead of raw params.
+// Channel types without a typed block keep the raw params set by channelToState.
+func readImportedChannel(ctx context.Context, channel *generated.NotificationChannel, state *NotificationChannelResourceModel, diags *diag.Diagnostics) {
+	state.Slack = types.ObjectNull(slackChannelAttrTypes)
+	state.Webhook = types.ObjectNull(webhookChannelAttrTypes)
+	state.Telegram = types.ObjectNull(telegramChannelAttrTypes)
+	state.Mattermost = types.ObjectNull(mattermostChannelAttrTypes)
+
+	var d diag.Diagnostics
+	switch channel.Type {
+	case generated.NotificationChannelTypeSlack:
+		state.Slack, d = types.ObjectValueFrom(ctx, slackChannelAttrTypes, SlackChannelModel{
+			WebhookURL:   paramString(channel.Params["webhookUrl"]),
+			WebhookURLWO: types.StringNull(),
+		})
+	case generated.NotificationChannelTypeMattermost:
+		state.Mattermost, d = types.ObjectValueFrom(ctx, mattermostChannelAttrTypes, MattermostChannelModel{
+			WebhookURL:   paramString(channel.Params["webhookUrl"]),
+			WebhookURLWO: types.StringNull(),
+		})
+	case generated.NotificationChannelTypeTelegram:
+		telegram := TelegramChannelModel{
+			BotToken:   paramString(channel.Params["botToken"]),
+			BotTokenWO: types.StringNull(),
+			ChatID:     paramChatID(channel.Params["chatId"]),
+		}
+		state.Telegram, d = types.ObjectValueFrom(ctx, telegramChannelAttrTypes, telegram)
+	case generated.NotificationChannelTypeWebhook:
+		webhook := WebhookChannelModel{
+			URL:     paramString(channel.Params["url"]),
+			Payload: payloadToState(channel.Params["payload"], types.StringNull()),
+			Headers: types.MapNull(types.StringType),
+		}
+		if headers, ok := channel.Params["headers"].(map[string]any); ok && len(headers) > 0 {
+			values := make(map[string]string, len(headers))
+			for k, v := range headers {
+				values[k] = fmt.Sprint(v)
+			}
+			webhook.Headers, d = types.MapValueFrom(ctx, types.StringType, values)
+			diags.Append(d...)
+		}
+		state.Webhook, d = types.ObjectValueFrom(ctx, webhookChannelAttrTypes, webhook)
+	default:
+		return
+	}
+	diags.Append(d...)
+	state.Params = types.MapNull(types.StringType)
+}
+
+// paramString converts a decoded JSON param to a string value, or null when it is missing or empty.
+func paramString(value any) types.String {
+	if s, ok := value.(string); ok && s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+// chatIDParam converts a Telegram chat ID to its API param. Numeric IDs are sent as JSON numbers,
+// while public channel usernames such as "@channelusername" are sent as strings.
+func chatIDParam(chatID string) any {
+	if n, err := strconv.ParseInt(chatID, 10, 64); err == nil {
+		return n
+	}
+	return chatID
+}
+
+// paramChatID converts a decoded Telegram chat ID param (number or string) to a string value,
+// or null when it is missing or empty.
+func paramChatID(value any) types.String {
+	switch v := value.(type) {
+	case float64:
+		return types.StringValue(strconv.FormatInt(int64(v), 10))
+	case int64:
+		return types.StringValue(strconv.FormatInt(v, 10))
+	default:
+		return paramString(value)
+	}
+}
+
+// payloadToState converts a webhook payload returned by the API to a JSON string.
+// The prior value is kept when it encodes the same JSON document, so formatting differences don't cause diffs.
+func payloadToState(payload any, prior types.String) types.String {
+	if payload == nil {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorPayload any
+		if err := json.Unmarshal([]byte(prior.ValueString()), &priorPayload); err == nil && reflect.DeepEqual(priorPayload, payload) {
+			return prior
+		}
+	}
+
+	jsonBytes, err := json.Marshal(payload)
+	if err != nil {
+		return prior
+	}
+	return types.StringValue(string(jsonBytes))
+}
+
+// secretValue returns the write-only value when set, otherwise the regular value.
+func secretValue(value, writeOnly types.String) string {
+	if !writeOnly.IsNull() && !writeOnly.IsUnknown() {
+		return writeOnly.ValueString()
+	}
+	return value.ValueString()
+}
+
+// redactedValue replaces secret values in log output.
+const redactedValue = "<redacted>"
+
+// channelSecretParamKeys lists channel params that hold credentials.
+var channelSecretParamKeys = map[string]bool{
+	"webhookurl": true,
+	"bottoken":   true,
+	"headers":    true,
+	"url":        true,
+}
+
+// channelSecretParamMarkers are substrings that mark any other param key as a secret.
+var channelSecretParamMarkers = []string{"token", "secret", "password", "key", "auth"}
+
+// redactChannelParams returns a copy of the channel params that is safe to log.
+// Known secret keys and keys that look like credentials have their values replaced.
+func redactChannelParams(params map[string]any) map[string]any {
+	redacted := make(map[string]any, len(params))
+	for k, v := range params {
+		if isSecretChannelParam(k) {
+			redacted[k] = redactedValue
+			continue
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// isSecretChannelParam reports whether a channel param key holds a credential.
+func isSecretChannelParam(key string) bool {
+	lower := strings.ToLower(key)
+	if channelSecretParamKeys[lower] {
+		return true
+	}
+	for _, marker := range channelSecretParamMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// newChannelPlan returns a channel plan with all typed blocks and params null.
+func newChannelPlan(channelType string) NotificationChannelResourceModel {
+	return NotificationChannelResourceModel{
+		Name:       types.StringValue("Alerts"),
+		Type:       types.StringValue(channelType),
+		Condition:  types.StringNull(),
+		Priority:   types.ListNull(types.StringType),
+		Params:     types.MapNull(types.StringType),
+		Slack:      types.ObjectNull(slackChannelAttrTypes),
+		Webhook:    types.ObjectNull(webhookChannelAttrTypes),
+		Telegram:   types.ObjectNull(telegramChannelAttrTypes),
+		Mattermost: types.ObjectNull(mattermostChannelAttrTypes),
+	}
+}
+
+func TestPlanToChannelInput_Slack(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("slack")
+	plan.Slack = types.ObjectValueMust(slackChannelAttrTypes, map[string]attr.Value{
+		"webhook_url":    types.StringValue("https://hooks.slack.com/services/T0/B0/X"),
+		"webhook_url_wo": types.StringNull(),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, generated.NotificationChannelInputType("slack"), input.Type)
+	assert.Equal(t, map[string]any{"webhookUrl": "https://hooks.slack.com/services/T0/B0/X"}, input.Params)
+}
+
+func TestPlanToChannelInput_Telegram(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("telegram")
+	plan.Telegram = types.ObjectValueMust(telegramChannelAttrTypes, map[string]attr.Value{
+		"bot_token":    types.StringValue("123456:ABC-DEF"),
+		"bot_token_wo": types.StringNull(),
+		"chat_id":      types.StringValue("-1001234567890"),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, "123456:ABC-DEF", input.Params["botToken"])
+	assert.Equal(t, int64(-1001234567890), input.Params["chatId"], "Numeric chat_id should be sent as a number")
+}
+
+func TestPlanToChannelInput_TelegramChannelUsername(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("telegram")
+	plan.Telegram = types.ObjectValueMust(telegramChannelAttrTypes, map[string]attr.Value{
+		"bot_token":    types.StringValue("123456:ABC-DEF"),
+		"bot_token_wo": types.StringNull(),
+		"chat_id":      types.StringValue("@channelusername"),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, "@channelusername", input.Params["chatId"], "Channel username should be sent as a string")
+
+	// The username is read back unchanged
+	var state NotificationChannelResourceModel
+	readTypedChannelBlocks(ctx, &generated.NotificationChannel{Params: input.Params}, plan, &state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	var telegram TelegramChannelModel
+	require.False(t, state.Telegram.As(ctx, &telegram, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "@channelusername", telegram.ChatID.ValueString())
+}
+
+func TestPlanToChannelInput_WriteOnlySecret(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("mattermost")
+	plan.Mattermost = types.ObjectValueMust(mattermostChannelAttrTypes, map[string]attr.Value{
+		"webhook_url":    types.StringNull(),
+		"webhook_url_wo": types.StringValue("https://mattermost.example.com/hooks/secret"),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, map[string]any{"webhookUrl": "https://mattermost.example.com/hooks/secret"}, input.Params)
+}
+
+func TestRedactChannelParams(t *testing.T) {
+	params := map[string]any{
+		"webhookUrl":  "https://hooks.slack.com/services/T0/B0/X",
+		"botToken":    "123456:ABC-DEF",
+		"chatId":      float64(-100),
+		"headers":     map[string]string{"Authorization": "Bearer secret"},
+		"routingKey":  "abc",
+		"channelName": "alerts",
+	}
+
+	redacted := redactChannelParams(params)
+
+	assert.Equal(t, map[string]any{
+		"webhookUrl":  redactedValue,
+		"botToken":    redactedValue,
+		"chatId":      float64(-100),
+		"headers":     redactedValue,
+		"routingKey":  redactedValue,
+		"channelName": "alerts",
+	}, redacted)
+	assert.Equal(t, "123456:ABC-DEF", params["botToken"], "Input params must not be modified")
+}
+
+func TestPlanToChannelInput_WebhookPayload(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("webhook")
+	plan.Webhook = types.ObjectValueMust(webhookChannelAttrTypes, map[string]attr.Value{
+		"url":     types.StringValue("https://example.com/webhook"),
+		"payload": types.StringValue(`{"text":"{{ .Alert.Name }}","nested":{"urgent":true,"level":2}}`),
+		"headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Authorization": types.StringValue("Bearer secret"),
+		}),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, "https://example.com/webhook", input.Params["url"])
+	assert.Equal(t, map[string]any{
+		"text":   "{{ .Alert.Name }}",
+		"nested": map[string]any{"urgent": true, "level": float64(2)},
+	}, input.Params["payload"])
+	assert.Equal(t, map[string]string{"Authorization": "Bearer secret"}, input.Params["headers"])
+}
+
+func TestPlanToChannelInput_ParamsFallback(t *testing.T) {
+	ctx := context.Background()
+	plan := newChannelPlan("webhook")
+	plan.Params = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"url": types.StringValue("https://example.com/webhook"),
+	})
+
+	var diags diag.Diagnostics
+	input := planToChannelInput(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, map[string]any{"url": "https://example.com/webhook"}, input.Params)
+}
+
+func TestReadTypedChannelBlocks_Telegram(t *testing.T) {
+	ctx := context.Background()
+	prior := newChannelPlan("telegram")
+	prior.Telegram = types.ObjectValueMust(telegramChannelAttrTypes, map[string]attr.Value{
+		"bot_token":    types.StringValue("123456:ABC-DEF"),
+		"bot_token_wo": types.StringNull(),
+		"chat_id":      types.StringValue("-100"),
+	})
+
+	// The API returns the chat ID as a JSON number and masks the token
+	channel := &generated.NotificationChannel{
+		Params: map[string]interface{}{"botToken": "******", "chatId": float64(-200)},
+	}
+
+	var state NotificationChannelResourceModel
+	var diags diag.Diagnostics
+	readTypedChannelBlocks(ctx, channel, prior, &state, &diags)
+
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	var telegram TelegramChannelModel
+	require.False(t, state.Telegram.As(ctx, &telegram, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "123456:ABC-DEF", telegram.BotToken.ValueString(), "Secret should keep its prior value")
+	assert.Equal(t, "-200", telegram.ChatID.ValueString(), "Chat ID should be refreshed from the API")
+	assert.True(t, state.Slack.IsNull())
+	assert.True(t, state.Webhook.IsNull())
+}
+
+func TestPayloadToState(t *testing.T) {
+	prior := types.StringValue(`{ "text": "alert",  "level": 2 }`)
+
+	// Same document with different formatting keeps the prior value
+	same := payloadToState(map[string]any{"level": float64(2), "text": "alert"}, prior)
+	assert.Equal(t, prior, same)
+
+	// A changed document is re-encoded
+	changed := payloadToState(map[string]any{"text": "changed"}, prior)
+	assert.Equal(t, `{"text":"changed"}`, changed.ValueString())
+
+	assert.True(t, payloadToState(nil, prior).IsNull())
+}
+
+func TestReadImportedChannel_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	channel := &generated.NotificationChannel{
+		Id:     7,
+		Name:   "Alerts",
+		Type:   generated.NotificationChannelTypeTelegram,
+		Status: "delivered",
+		Params: map[string]any{"botToken": "123456:ABC-DEF", "chatId": float64(-1001234567890)},
+	}
+
+	var diags diag.Diagnostics
+	prior := newChannelPlan("")
+	require.True(t, isImportedChannel(prior))
+
+	state := prior
+	channelToState(ctx, channel, &state, &diags)
+	readImportedChannel(ctx, channel, &state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	assert.True(t, state.Params.IsNull(), "Raw params should be omitted when a typed block exists")
+	assert.False(t, isImportedChannel(state))
+
+	// The generated configuration sends the same params back to Uptrace
+	input := planToChannelInput(ctx, state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.Equal(t, map[string]any{"botToken": "123456:ABC-DEF", "chatId": int64(-1001234567890)}, input.Params)
+}
+
+func TestReadImportedChannel_WebhookPayload(t *testing.T) {
+	ctx := context.Background()
+	channel := &generated.NotificationChannel{
+		Id:   8,
+		Name: "Hook",
+		Type: generated.NotificationChannelTypeWebhook,
+		Params: map[string]any{
+			"url":     "https://example.com/webhook",
+			"payload": map[string]any{"source": "uptrace"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	state := newChannelPlan("")
+	channelToState(ctx, channel, &state, &diags)
+	readImportedChannel(ctx, channel, &state, &diags)
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	var webhook WebhookChannelModel
+	diags.Append(state.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})...)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "https://example.com/webhook", webhook.URL.ValueString())
+	assert.JSONEq(t, `{"source":"uptrace"}`, webhook.Payload.ValueString())
+	assert.True(t, webhook.Headers.IsNull())
+}
@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -21,6 +26,23 @@ var (
 	_ resource.Resource                = &NotificationChannelResource{}
 	_ resource.ResourceWithConfigure   = &NotificationChannelResource{}
 	_ resource.ResourceWithImportState = &NotificationChannelResource{}
+
+	_ resource.ResourceWithConfigValidators = &NotificationChannelResource{}
+	_ resource.ResourceWithValidateConfig   = &NotificationChannelResource{}
+)
+
+var (
+	// channelURLRegexp matches the webhook URLs accepted by the typed channel blocks.
+	channelURLRegexp = regexp.MustCompile(`^https?://\S+$`)
+
+	// telegramBotTokenRegexp matches Telegram bot tokens such as "123456:ABC-DEF1234".
+	telegramBotTokenRegexp = regexp.MustCompile(`^\d+:[A-Za-z0-9_-]+$`)
+
+	// telegramChatIDRegexp matches numeric Telegram chat IDs and public channel usernames such as "@channelusername".
+	telegramChatIDRegexp = regexp.MustCompile(`^(-?\d+|@[A-Za-z][A-Za-z0-9_]{4,31})$`)
+
+	// typedChannelBlocks lists the typed channel blocks; each block is named after its channel type.
+	typedChannelBlocks = []string{"slack", "webhook", "telegram", "mattermost"}
 )
 
 // NewNotificationChannelResource is a helper function to create the resource.
@@ -35,15 +57,21 @@ type NotificationChannelResource struct {
 
 // NotificationChannelResourceModel describes the resource data model.
 type NotificationChannelResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Condition types.String `tfsdk:"condition"`
-	Priority  types.List   `tfsdk:"priority"`
-	Params    types.Map    `tfsdk:"params"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID               types.String `tfsdk:"id"`
+	ProjectID        types.Int64  `tfsdk:"project_id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Condition        types.String `tfsdk:"condition"`
+	Priority         types.List   `tfsdk:"priority"`
+	Params           types.Map    `tfsdk:"params"`
+	Slack            types.Object `tfsdk:"slack"`
+	Webhook          types.Object `tfsdk:"webhook"`
+	Telegram         types.Object `tfsdk:"telegram"`
+	Mattermost       types.Object `tfsdk:"mattermost"`
+	SecretsWOVersion types.Int64  `tfsdk:"secrets_wo_version"`
+	Status           types.String `tfsdk:"status"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
 
 // Metadata returns the resource type name.
@@ -63,6 +91,7 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": projectIDResourceAttribute("notification channel"),
 			"name": schema.StringAttribute{
 				Description: "Channel name.",
 				Required:    true,
@@ -85,11 +114,126 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 				Optional:    true,
 			},
 			"params": schema.MapAttribute{
-				Description: "Channel-specific configuration parameters. Structure varies by channel type.",
+				Description: "Raw channel-specific configuration parameters. Structure varies by channel type. " +
+					"Fallback for channel types without a typed block; conflicts with slack, webhook, telegram and mattermost.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"slack": schema.SingleNestedAttribute{
+				Description: "Slack channel configuration. Requires type = \"slack\".",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Description: "Slack incoming webhook URL. Stored in state; prefer webhook_url_wo on Terraform 1.11 and later.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(channelURLRegexp, "must be an http(s) URL"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("webhook_url_wo")),
+						},
+					},
+					"webhook_url_wo": schema.StringAttribute{
+						Description: "Write-only Slack incoming webhook URL that is never stored in state. " +
+							"Bump secrets_wo_version to send a new value.",
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(channelURLRegexp, "must be an http(s) URL"),
+						},
+					},
+				},
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "Generic webhook channel configuration. Requires type = \"webhook\".",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL the alert notifications are posted to.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(channelURLRegexp, "must be an http(s) URL"),
+						},
+					},
+					"payload": schema.StringAttribute{
+						Description: "Custom JSON payload template, usually built with jsonencode().",
+						Optional:    true,
+						Validators: []validator.String{
+							jsonObjectValidator{},
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "Additional HTTP headers sent with each request.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"telegram": schema.SingleNestedAttribute{
+				Description: "Telegram channel configuration. Requires type = \"telegram\".",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"bot_token": schema.StringAttribute{
+						Description: "Telegram bot token in the form <bot_id>:<secret>. Stored in state; prefer bot_token_wo on Terraform 1.11 and later.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(telegramBotTokenRegexp, "must be a Telegram bot token in the form <bot_id>:<secret>"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("bot_token_wo")),
+						},
+					},
+					"bot_token_wo": schema.StringAttribute{
+						Description: "Write-only Telegram bot token that is never stored in state. " +
+							"Bump secrets_wo_version to send a new value.",
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(telegramBotTokenRegexp, "must be a Telegram bot token in the form <bot_id>:<secret>"),
+						},
+					},
+					"chat_id": schema.StringAttribute{
+						Description: "Telegram chat ID, such as \"-1001234567890\", or the username of a public channel, such as \"@channelusername\". " +
+							"Group and channel IDs are negative.",
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(telegramChatIDRegexp, "must be a numeric chat ID or a channel username such as @channelusername"),
+						},
+					},
+				},
+			},
+			"mattermost": schema.SingleNestedAttribute{
+				Description: "Mattermost channel configuration. Requires type = \"mattermost\".",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Description: "Mattermost incoming webhook URL. Stored in state; prefer webhook_url_wo on Terraform 1.11 and later.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(channelURLRegexp, "must be an http(s) URL"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("webhook_url_wo")),
+						},
+					},
+					"webhook_url_wo": schema.StringAttribute{
+						Description: "Write-only Mattermost incoming webhook URL that is never stored in state. " +
+							"Bump secrets_wo_version to send a new value.",
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(channelURLRegexp, "must be an http(s) URL"),
+						},
+					},
+				},
+			},
+			"secrets_wo_version": schema.Int64Attribute{
+				Description: "Version of the write-only secrets. Terraform cannot detect changes to write-only " +
+					"attributes, so change this value to send updated secrets to Uptrace.",
+				Optional: true,
+			},
 			"status": schema.StringAttribute{
 				Description: "Channel delivery status (computed).",
 				Computed:    true,
@@ -106,6 +250,43 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+// ConfigValidators returns the resource-level validators.
+func (r *NotificationChannelResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("params"),
+			path.MatchRoot("slack"),
+			path.MatchRoot("webhook"),
+			path.MatchRoot("telegram"),
+			path.MatchRoot("mattermost"),
+		),
+	}
+}
+
+// ValidateConfig checks that the configured typed channel block matches the channel type.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var channelType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &channelType)...)
+	if resp.Diagnostics.HasError() || channelType.IsNull() || channelType.IsUnknown() {
+		return
+	}
+
+	for _, block := range typedChannelBlocks {
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block), &value)...)
+		if value.IsNull() || block == channelType.ValueString() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(block),
+			"Channel Block Does Not Match Type",
+			fmt.Sprintf("The %s block can only be used with type = %q, got %q.", block, block, channelType.ValueString()),
+		)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *NotificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -136,14 +317,22 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 
 	tflog.Info(ctx, "Creating notification channel", map[string]any{"name": plan.Name.ValueString()})
 
+	// Write-only secrets are only available in the configuration
+	var config NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert plan to API input
-	input := planToChannelInput(ctx, plan, &resp.Diagnostics)
+	input := planToChannelInput(ctx, withConfigChannelBlocks(plan, config), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create channel via API
-	channel, err := r.client.CreateNotificationChannel(ctx, input)
+	plan.ProjectID = resolvedProjectID(r.client, plan.ProjectID)
+	channel, err := clientForProject(r.client, plan.ProjectID).CreateNotificationChannel(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Notification Channel",
@@ -152,8 +341,8 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	// Preserve params from plan (sensitive field that API may not return)
-	plannedParams := plan.Params
+	// Preserve params from plan (sensitive fields that API may not return)
+	planned := plan
 
 	// Convert API response to state
 	channelToState(ctx, channel, &plan, &resp.Diagnostics)
@@ -162,7 +351,7 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Restore params from plan (sensitive values not returned by API)
-	plan.Params = plannedParams
+	restoreChannelParams(planned, &plan)
 
 	// Save state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -192,7 +381,8 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get channel from API
-	channel, err := r.client.GetNotificationChannel(ctx, channelID)
+	state.ProjectID = resolvedProjectID(r.client, state.ProjectID)
+	channel, err := clientForProject(r.client, state.ProjectID).GetNotificationChannel(ctx, channelID)
 	if err != nil {
 		if isNotFoundError(err) {
 			tflog.Warn(ctx, "Notification channel not found, removing from state", map[string]any{"id": state.ID.ValueString()})
@@ -206,8 +396,8 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// Preserve params from prior state (sensitive field that API may not return)
-	priorParams := state.Params
+	// Preserve params from prior state (sensitive fields that API may not return)
+	prior := state
 
 	// Convert API response to state
 	channelToState(ctx, channel, &state, &resp.Diagnostics)
@@ -215,8 +405,14 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// Restore params from prior state (sensitive values not returned by API)
-	state.Params = priorParams
+	// Restore params from prior state and refresh the typed block from the API.
+	// After an import nothing is known yet, so the typed block is read in full.
+	if isImportedChannel(prior) {
+		readImportedChannel(ctx, channel, &state, &resp.Diagnostics)
+	} else {
+		state.Params = prior.Params
+		readTypedChannelBlocks(ctx, channel, prior, &state, &resp.Diagnostics)
+	}
 
 	// Save state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -245,14 +441,21 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Write-only secrets are only available in the configuration
+	var config NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert plan to API input
-	input := planToChannelInput(ctx, plan, &resp.Diagnostics)
+	input := planToChannelInput(ctx, withConfigChannelBlocks(plan, config), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update channel via API
-	channel, err := r.client.UpdateNotificationChannel(ctx, channelID, input)
+	channel, err := clientForProject(r.client, plan.ProjectID).UpdateNotificationChannel(ctx, channelID, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Notification Channel",
@@ -261,8 +464,8 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	// Preserve params from plan (sensitive field that API may not return)
-	plannedParams := plan.Params
+	// Preserve params from plan (sensitive fields that API may not return)
+	planned := plan
 
 	// Convert API response to state
 	channelToState(ctx, channel, &plan, &resp.Diagnostics)
@@ -271,7 +474,7 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Restore params from plan (sensitive values not returned by API)
-	plan.Params = plannedParams
+	restoreChannelParams(planned, &plan)
 
 	// Save state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -301,7 +504,7 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete channel via API
-	err = r.client.DeleteNotificationChannel(ctx, channelID)
+	err = clientForProject(r.client, state.ProjectID).DeleteNotificationChannel(ctx, channelID)
 	if err != nil {
 		if isNotFoundError(err) {
 			tflog.Warn(ctx, "Notification channel already deleted", map[string]any{"id": state.ID.ValueString()})
@@ -317,7 +520,79 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 	tflog.Info(ctx, "Successfully deleted notification channel", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state using an ID of the form
+// "[<project_id>/](<channel_id>|name:<channel_name>)".
 func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, r.client, "notification channel", findChannelIDsByName, req, resp)
+}
+
+// findChannelIDsByName returns the IDs of all notification channels with the given exact name.
+func findChannelIDsByName(ctx context.Context, apiClient *client.Client, name string) ([]int64, error) {
+	channels, err := apiClient.ListNotificationChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for i := range channels {
+		if channels[i].Name == name {
+			ids = append(ids, channels[i].Id)
+		}
+	}
+	return ids, nil
+}
+
+// withConfigChannelBlocks returns the plan with its typed channel blocks taken from the configuration,
+// which is the only place write-only secrets are available. The blocks have no computed attributes,
+// so every other value matches the plan.
+//
+//nolint:gocritic // Models passed by value to keep function signatures consistent
+func withConfigChannelBlocks(plan, config NotificationChannelResourceModel) NotificationChannelResourceModel {
+	plan.Slack = config.Slack
+	plan.Webhook = config.Webhook
+	plan.Telegram = config.Telegram
+	plan.Mattermost = config.Mattermost
+	return plan
+}
+
+// restoreChannelParams copies the raw params and typed channel blocks from the source model.
+//
+//nolint:gocritic // Source model passed by value to keep function signatures consistent
+func restoreChannelParams(src NotificationChannelResourceModel, dst *NotificationChannelResourceModel) {
+	dst.Params = src.Params
+	dst.Slack = src.Slack
+	dst.Webhook = src.Webhook
+	dst.Telegram = src.Telegram
+	dst.Mattermost = src.Mattermost
+}
+
+// jsonObjectValidator validates that a string holds a JSON object.
+type jsonObjectValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v jsonObjectValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("Value must be a JSON object: %s", err.Error()),
+		)
+	}
 }
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
 )
@@ -33,10 +35,11 @@ func TestAccNotificationChannelResource_Slack(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:            "uptrace_notification_channel.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"params"}, // params are sensitive
+				ResourceName:      "uptrace_notification_channel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported channels read their secrets into the typed block instead of params
+				ImportStateVerifyIgnore: []string{"params", "slack"},
 			},
 			// Update and Read testing
 			{
@@ -99,6 +102,131 @@ func TestAccNotificationChannelResource_Webhook(t *testing.T) {
 // 	})
 // }
 
+func TestAccNotificationChannelResource_TypedWebhook(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+		return
+	}
+
+	resourceName := "uptrace_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelResourceConfigTypedWebhook("critical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "webhook"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.payload", `{"level":"critical","notify":true,"retries":3}`),
+					resource.TestCheckNoResourceAttr(resourceName, "params"),
+				),
+			},
+			// Re-applying the same config must not produce a diff
+			{
+				Config:   testAccNotificationChannelResourceConfigTypedWebhook("critical"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccNotificationChannelResourceConfigTypedWebhook("warning"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhook.payload", `{"level":"warning","notify":true,"retries":3}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelResource_TypedTelegram(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+		return
+	}
+
+	resourceName := "uptrace_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelResourceConfigTypedTelegram(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "telegram.chat_id", "-1001234567890"),
+				),
+			},
+			{
+				Config:   testAccNotificationChannelResourceConfigTypedTelegram(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelResource_WriteOnlySecret(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+		return
+	}
+
+	resourceName := "uptrace_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelResourceConfigWriteOnly(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "secrets_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "slack.webhook_url"),
+					resource.TestCheckNoResourceAttr(resourceName, "slack.webhook_url_wo"),
+				),
+			},
+			// Rotating the secret requires bumping the version
+			{
+				Config: testAccNotificationChannelResourceConfigWriteOnly(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "slack.webhook_url_wo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelResource_BlockTypeMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptancetests.GetTestProviderConfig() + `
+resource "uptrace_notification_channel" "test" {
+  name = "test-mismatch"
+  type = "webhook"
+
+  slack = {
+    webhook_url = "https://hooks.slack.com/services/test"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Channel Block Does Not Match Type`),
+			},
+		},
+	})
+}
+
 func TestAccNotificationChannelResource_Disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
@@ -179,6 +307,53 @@ resource "uptrace_notification_channel" "test" {
 `
 }
 
+func testAccNotificationChannelResourceConfigTypedWebhook(level string) string {
+	return acceptancetests.GetTestProviderConfig() + fmt.Sprintf(`
+resource "uptrace_notification_channel" "test" {
+  name = "test-typed-webhook"
+  type = "webhook"
+
+  webhook = {
+    url = "https://example.com/webhook"
+    payload = jsonencode({
+      level   = %[1]q
+      notify  = true
+      retries = 3
+    })
+  }
+}
+`, level)
+}
+
+func testAccNotificationChannelResourceConfigTypedTelegram() string {
+	return acceptancetests.GetTestProviderConfig() + `
+resource "uptrace_notification_channel" "test" {
+  name = "test-typed-telegram"
+  type = "telegram"
+
+  telegram = {
+    bot_token = "123456:ABC-DEF1234ghIkl"
+    chat_id   = "-1001234567890"
+  }
+}
+`
+}
+
+func testAccNotificationChannelResourceConfigWriteOnly(version int) string {
+	return acceptancetests.GetTestProviderConfig() + fmt.Sprintf(`
+resource "uptrace_notification_channel" "test" {
+  name = "test-write-only-slack"
+  type = "slack"
+
+  slack = {
+    webhook_url_wo = "https://hooks.slack.com/services/test/v%[1]d"
+  }
+
+  secrets_wo_version = %[1]d
+}
+`, version)
+}
+
 func testAccNotificationChannelCloudWithPriority(name string) string {
 	return acceptancetests.GetTestProviderConfig() + fmt.Sprintf(`
 resource "uptrace_notification_channel" "test" {
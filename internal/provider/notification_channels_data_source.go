@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/client/generated"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &NotificationChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &NotificationChannelsDataSource{}
+)
+
+// NewNotificationChannelsDataSource is a helper function to create the data source.
+func NewNotificationChannelsDataSource() datasource.DataSource {
+	return &NotificationChannelsDataSource{}
+}
+
+// NotificationChannelsDataSource is the data source implementation.
+type NotificationChannelsDataSource struct {
+	client *client.Client
+}
+
+// NotificationChannelsDataSourceModel describes the data source data model.
+type NotificationChannelsDataSourceModel struct {
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	Type      types.String `tfsdk:"type"`
+	Status    types.String `tfsdk:"status"`
+	Priority  types.String `tfsdk:"priority"`
+	Channels  types.List   `tfsdk:"channels"`
+}
+
+// NotificationChannelModel describes an individual notification channel in the list.
+type NotificationChannelModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Condition    types.String `tfsdk:"condition"`
+	Priority     types.List   `tfsdk:"priority"`
+	Params       types.Map    `tfsdk:"params"`
+	Status       types.String `tfsdk:"status"`
+	MonitorIDs   types.List   `tfsdk:"monitor_ids"`
+	MonitorCount types.Int64  `tfsdk:"monitor_count"`
+}
+
+// notificationChannelModelAttrTypes are the attribute types of a notification channel in the list.
+var notificationChannelModelAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"type":          types.StringType,
+	"condition":     types.StringType,
+	"priority":      types.ListType{ElemType: types.StringType},
+	"params":        types.MapType{ElemType: types.StringType},
+	"status":        types.StringType,
+	"monitor_ids":   types.ListType{ElemType: types.Int64Type},
+	"monitor_count": types.Int64Type,
+}
+
+// Metadata returns the data source type name.
+func (d *NotificationChannelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channels"
+}
+
+// Schema defines the schema for the data source.
+func (d *NotificationChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a list of Uptrace notification channels with optional filtering.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDDataSourceAttribute(),
+			"type": schema.StringAttribute{
+				Description: "Filter channels by type (slack, webhook, telegram or mattermost).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("slack", "webhook", "telegram", "mattermost"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Filter channels by delivery status.",
+				Optional:    true,
+			},
+			"priority": schema.StringAttribute{
+				Description: "Only return channels that receive this alert priority (Info, Low, Medium or High).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Info", "Low", "Medium", "High"),
+				},
+			},
+			"channels": schema.ListNestedAttribute{
+				Description: "List of notification channels matching the filter criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Notification channel identifier.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Channel name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Channel type (slack, webhook, telegram or mattermost).",
+							Computed:    true,
+						},
+						"condition": schema.StringAttribute{
+							Description: "Condition expression used to filter notifications.",
+							Computed:    true,
+						},
+						"priority": schema.ListAttribute{
+							Description: "Alert priority levels the channel receives.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"params": schema.MapAttribute{
+							Description: "Channel-specific configuration parameters.",
+							ElementType: types.StringType,
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Channel delivery status.",
+							Computed:    true,
+						},
+						"monitor_ids": schema.ListAttribute{
+							Description: "IDs of the monitors using this channel.",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"monitor_count": schema.Int64Attribute{
+							Description: "Number of monitors using this channel.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NotificationChannelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uptraceClient, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = uptraceClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (d *NotificationChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NotificationChannelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading notification channels data source", map[string]any{
+		"type_filter":     config.Type.ValueString(),
+		"status_filter":   config.Status.ValueString(),
+		"priority_filter": config.Priority.ValueString(),
+	})
+
+	// Fetch all channels from API
+	apiClient := clientForProject(d.client, config.ProjectID)
+	config.ProjectID = types.Int64Value(apiClient.ProjectID())
+	channels, err := apiClient.ListNotificationChannels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Channels",
+			fmt.Sprintf("Could not list notification channels: %s", err.Error()),
+		)
+		return
+	}
+
+	// Apply filters
+	filtered := filterChannels(channels, config.Type, config.Status, config.Priority)
+
+	tflog.Debug(ctx, "Filtered notification channels", map[string]any{
+		"total_count":    len(channels),
+		"filtered_count": len(filtered),
+	})
+
+	// Convert to Terraform state
+	channelModels := make([]NotificationChannelModel, 0, len(filtered))
+	for i := range filtered {
+		channelModels = append(channelModels, convertChannelToModel(ctx, &filtered[i], &resp.Diagnostics))
+	}
+
+	channelList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: notificationChannelModelAttrTypes}, channelModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Channels = channelList
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Info(ctx, "Successfully read notification channels data source", map[string]any{"count": len(channelModels)})
+}
+
+// filterChannels applies filters to the notification channel list.
+func filterChannels(channels []generated.NotificationChannel, typeFilter, statusFilter, priorityFilter types.String) []generated.NotificationChannel {
+	var filtered []generated.NotificationChannel
+
+	//nolint:gocritic // Large struct copy acceptable for filtering logic
+	for _, channel := range channels {
+		// Skip if type filter doesn't match
+		if !typeFilter.IsNull() && !typeFilter.IsUnknown() {
+			if string(channel.Type) != typeFilter.ValueString() {
+				continue
+			}
+		}
+
+		// Skip if status filter doesn't match
+		if !statusFilter.IsNull() && !statusFilter.IsUnknown() {
+			if channel.Status != statusFilter.ValueString() {
+				continue
+			}
+		}
+
+		// Skip if the channel doesn't receive the requested priority
+		if !priorityFilter.IsNull() && !priorityFilter.IsUnknown() {
+			priority := generated.NotificationChannelPriority(priorityFilter.ValueString())
+			if channel.Priority == nil || !slices.Contains(*channel.Priority, priority) {
+				continue
+			}
+		}
+
+		filtered = append(filtered, channel)
+	}
+
+	return filtered
+}
+
+// convertChannelToModel converts an API NotificationChannel to NotificationChannelModel.
+func convertChannelToModel(ctx context.Context, channel *generated.NotificationChannel, diags *diag.Diagnostics) NotificationChannelModel {
+	// Reuse the resource conversion for the shared fields
+	var tempModel NotificationChannelResourceModel
+	channelToState(ctx, channel, &tempModel, diags)
+
+	var monitorIDs []int64
+	if channel.MonitorIds != nil {
+		monitorIDs = *channel.MonitorIds
+	}
+	monitorIDList, d := types.ListValueFrom(ctx, types.Int64Type, monitorIDs)
+	diags.Append(d...)
+
+	// monitorCount is only returned by the list endpoint
+	monitorCount := int64(len(monitorIDs))
+	if channel.MonitorCount != nil {
+		monitorCount = int64(*channel.MonitorCount)
+	}
+
+	return NotificationChannelModel{
+		ID:           tempModel.ID,
+		Name:         tempModel.Name,
+		Type:         tempModel.Type,
+		Condition:    tempModel.Condition,
+		Priority:     tempModel.Priority,
+		Params:       tempModel.Params,
+		Status:       tempModel.Status,
+		MonitorIDs:   monitorIDList,
+		MonitorCount: types.Int64Value(monitorCount),
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	acceptancetests "github.com/riccap/terraform-provider-uptrace/internal/acceptance_tests"
+)
+
+func TestAccNotificationChannelsDataSource_All(t *testing.T) {
+	dataSourceName := "data.uptrace_notification_channels.test"
+	prefix := acceptancetests.RandomTestName("tf-acc-ds-channels")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelsDataSourceConfig(prefix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// At least the two channels created by the config
+					resource.TestMatchResourceAttr(dataSourceName, "channels.#", regexp.MustCompile(`^([2-9]|\d{2,})$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelsDataSource_FilterByType(t *testing.T) {
+	dataSourceName := "data.uptrace_notification_channels.test"
+	prefix := acceptancetests.RandomTestName("tf-acc-ds-channels-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelsDataSourceConfig(prefix, `type = "slack"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "channels.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "channels.0.type", "slack"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationChannelsDataSource_InvalidPriority(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptancetests.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptancetests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "uptrace_notification_channels" "test" {
+  priority = "critical"
+}
+`, acceptancetests.GetTestProviderConfig()),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+// testAccNotificationChannelsDataSourceConfig generates a config with a Slack and a webhook channel.
+func testAccNotificationChannelsDataSourceConfig(prefix, filters string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "uptrace_notification_channel" "slack" {
+  name = "%[2]s-slack"
+  type = "slack"
+
+  params = {
+    webhookUrl = "https://hooks.slack.com/services/test"
+  }
+}
+
+resource "uptrace_notification_channel" "webhook" {
+  name = "%[2]s-webhook"
+  type = "webhook"
+
+  params = {
+    url = "https://example.com/webhook"
+  }
+}
+
+data "uptrace_notification_channels" "test" {
+  %[3]s
+
+  depends_on = [
+    uptrace_notification_channel.slack,
+    uptrace_notification_channel.webhook,
+  ]
+}
+`, acceptancetests.GetTestProviderConfig(), prefix, filters)
+}
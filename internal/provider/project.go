@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+// projectIDResourceAttribute returns the schema of the project_id resource attribute.
+// Moving a resource to another project replaces it.
+func projectIDResourceAttribute(resourceName string) resourceschema.Int64Attribute {
+	return resourceschema.Int64Attribute{
+		Description: fmt.Sprintf("Uptrace project ID the %s belongs to. Defaults to the provider project_id. "+
+			"Changing this forces a new %s to be created.", resourceName, resourceName),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// projectIDDataSourceAttribute returns the schema of the project_id data source attribute.
+func projectIDDataSourceAttribute() datasourceschema.Int64Attribute {
+	return datasourceschema.Int64Attribute{
+		Description: "Uptrace project ID to read from. Defaults to the provider project_id.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// clientForProject returns a client for the project set in projectID,
+// or the provider client when projectID is null or unknown.
+func clientForProject(c *client.Client, projectID types.Int64) *client.Client {
+	if projectID.IsNull() || projectID.IsUnknown() {
+		return c
+	}
+	return c.ForProject(projectID.ValueInt64())
+}
+
+// importNamePrefix marks an import ID that refers to an object by its exact name.
+const importNamePrefix = "name:"
+
+// importID is a parsed import ID of the form "[<project_id>/](<resource_id>|name:<name>)".
+type importID struct {
+	// ProjectID is null when the import ID has no project prefix.
+	ProjectID types.Int64
+	// ResourceID is set when the object is imported by ID.
+	ResourceID string
+	// Name is set when the object is imported by name.
+	Name string
+}
+
+// parseImportID parses an import ID of the form "[<project_id>/](<resource_id>|name:<name>)".
+// Names may contain slashes, so an ID starting with "name:" never has a project prefix.
+func parseImportID(id string, diags *diag.Diagnostics) (importID, bool) {
+	result := importID{ProjectID: types.Int64Null()}
+
+	rest := id
+	if !strings.HasPrefix(id, importNamePrefix) {
+		if projectPart, resourcePart, found := strings.Cut(id, "/"); found {
+			projectID, err := strconv.ParseInt(projectPart, 10, 64)
+			if err != nil || projectID <= 0 {
+				addInvalidImportIDError(id, diags)
+				return importID{}, false
+			}
+			result.ProjectID = types.Int64Value(projectID)
+			rest = resourcePart
+		}
+	}
+
+	if name, ok := strings.CutPrefix(rest, importNamePrefix); ok {
+		if name == "" {
+			addInvalidImportIDError(id, diags)
+			return importID{}, false
+		}
+		result.Name = name
+		return result, true
+	}
+
+	if rest == "" || strings.Contains(rest, "/") {
+		addInvalidImportIDError(id, diags)
+		return importID{}, false
+	}
+	result.ResourceID = rest
+	return result, true
+}
+
+// addInvalidImportIDError reports an import ID that parseImportID cannot parse.
+func addInvalidImportIDError(id string, diags *diag.Diagnostics) {
+	diags.AddError(
+		"Invalid Import ID",
+		fmt.Sprintf("Expected import ID in the format <resource_id>, name:<name>, <project_id>/<resource_id> "+
+			"or <project_id>/name:<name>, got: %q", id),
+	)
+}
+
+// importNameLookup returns the IDs of all objects in the project with the given exact name.
+type importNameLookup func(ctx context.Context, apiClient *client.Client, name string) ([]int64, error)
+
+// importStateWithProject imports a resource using an ID of the form
+// "[<project_id>/](<resource_id>|name:<name>)". Names are resolved with lookup
+// and must match exactly one object; kind names the object in diagnostics.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func importStateWithProject(
+	ctx context.Context,
+	baseClient *client.Client,
+	kind string,
+	lookup importNameLookup,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, ok := parseImportID(req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resourceID := id.ResourceID
+	if id.Name != "" {
+		apiClient := clientForProject(baseClient, id.ProjectID)
+		ids, err := lookup(ctx, apiClient, id.Name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resolving Import Name",
+				fmt.Sprintf("Could not list %ss to resolve %q: %s", kind, id.Name, err.Error()),
+			)
+			return
+		}
+
+		resourceID, ok = matchImportName(kind, id.Name, apiClient.ProjectID(), ids, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		tflog.Debug(ctx, "Resolved import name", map[string]any{"name": id.Name, "id": resourceID})
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), resourceID)...)
+	if !id.ProjectID.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), id.ProjectID)...)
+	}
+}
+
+// matchImportName returns the single ID matching an import name.
+// No match or several matches are reported as errors.
+func matchImportName(kind, name string, projectID int64, ids []int64, diags *diag.Diagnostics) (string, bool) {
+	switch len(ids) {
+	case 0:
+		diags.AddError(
+			"Import Name Not Found",
+			fmt.Sprintf("No %s named %q exists in project %d.", kind, name, projectID),
+		)
+		return "", false
+	case 1:
+		return strconv.FormatInt(ids[0], 10), true
+	default:
+		diags.AddError(
+			"Ambiguous Import Name",
+			fmt.Sprintf("Found %d %ss named %q in project %d (IDs %v). Import the %s by ID instead.",
+				len(ids), kind, name, projectID, ids, kind),
+		)
+		return "", false
+	}
+}
+
+// resolvedProjectID returns projectID when it is known, or the project of the provider client.
+func resolvedProjectID(c *client.Client, projectID types.Int64) types.Int64 {
+	if projectID.IsNull() || projectID.IsUnknown() {
+		return types.Int64Value(c.ProjectID())
+	}
+	return projectID
+}
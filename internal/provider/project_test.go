@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		importID   string
+		projectID  types.Int64
+		resourceID string
+		name       string
+	}{
+		{importID: "42", projectID: types.Int64Null(), resourceID: "42"},
+		{importID: "7/42", projectID: types.Int64Value(7), resourceID: "42"},
+		{importID: "name:High CPU", projectID: types.Int64Null(), name: "High CPU"},
+		{importID: "name:API / errors", projectID: types.Int64Null(), name: "API / errors"},
+		{importID: "7/name:API / errors", projectID: types.Int64Value(7), name: "API / errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			id, ok := parseImportID(tt.importID, &diags)
+			require.True(t, ok)
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.projectID, id.ProjectID)
+			assert.Equal(t, tt.resourceID, id.ResourceID)
+			assert.Equal(t, tt.name, id.Name)
+		})
+	}
+
+	for _, invalid := range []string{"", "7/", "/42", "abc/42", "0/42", "-1/42", "1/2/3", "name:", "7/name:"} {
+		diags := diag.Diagnostics{}
+		_, ok := parseImportID(invalid, &diags)
+		assert.False(t, ok, "Import ID %q should be rejected", invalid)
+		assert.True(t, diags.HasError())
+	}
+}
+
+func TestMatchImportName(t *testing.T) {
+	diags := diag.Diagnostics{}
+	id, ok := matchImportName("monitor", "High CPU", 1, []int64{42}, &diags)
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "42", id)
+
+	diags = diag.Diagnostics{}
+	_, ok = matchImportName("monitor", "High CPU", 1, nil, &diags)
+	assert.False(t, ok)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Import Name Not Found", diags[0].Summary())
+
+	diags = diag.Diagnostics{}
+	_, ok = matchImportName("monitor", "High CPU", 1, []int64{42, 43}, &diags)
+	assert.False(t, ok)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Ambiguous Import Name", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "[42 43]")
+}
+
+func TestClientForProject(t *testing.T) {
+	c, err := client.New(client.Config{Endpoint: "http://localhost", Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+
+	assert.Same(t, c, clientForProject(c, types.Int64Null()))
+	assert.Same(t, c, clientForProject(c, types.Int64Unknown()))
+	assert.Equal(t, int64(2), clientForProject(c, types.Int64Value(2)).ProjectID())
+
+	assert.Equal(t, int64(1), resolvedProjectID(c, types.Int64Null()).ValueInt64())
+	assert.Equal(t, int64(1), resolvedProjectID(c, types.Int64Unknown()).ValueInt64())
+	assert.Equal(t, int64(2), resolvedProjectID(c, types.Int64Value(2)).ValueInt64())
+}
+
+func TestMonitorResourceImportState_ByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/7/monitors", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"monitors": [
+			{"id": 41, "name": "High CPU (staging)", "type": "metric", "state": "open"},
+			{"id": 42, "name": "High CPU", "type": "metric", "state": "open"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	r := &MonitorResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "7/name:High CPU"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+	var id types.String
+	var projectID types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "42", id.ValueString())
+	assert.Equal(t, int64(7), projectID.ValueInt64())
+}
+
+func TestDashboardGridResourcesImportState_WithProject(t *testing.T) {
+	ctx := context.Background()
+
+	for name, r := range map[string]resource.ResourceWithImportState{
+		"row":  &DashboardGridRowResource{},
+		"item": &DashboardGridItemResource{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: "2/123/456"}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+			var id, dashboardID types.String
+			var projectID types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("dashboard_id"), &dashboardID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "456", id.ValueString())
+			assert.Equal(t, "123", dashboardID.ValueString())
+			assert.Equal(t, int64(2), projectID.ValueInt64())
+		})
+	}
+}
+
+func TestDashboardGridRowResourceRead_UsesProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics/2/dashboards/123", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"dashboard": {"id": 123, "projectId": 2, "name": "Dashboard"},
+			"gridRows": [{"id": 456, "dashId": 123, "title": "Traffic", "index": 0}]
+		}`))
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.Config{Endpoint: server.URL, Token: "token", ProjectID: 1})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	r := &DashboardGridRowResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, &DashboardGridRowResourceModel{
+		ID:          types.StringValue("456"),
+		DashboardID: types.StringValue("123"),
+		ProjectID:   types.Int64Value(2),
+		Title:       types.StringValue("Traffic"),
+		Description: types.StringNull(),
+		Expanded:    types.BoolValue(true),
+		Index:       types.Int64Value(0),
+		CreatedAt:   types.StringNull(),
+		UpdatedAt:   types.StringNull(),
+	})
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Unexpected diagnostics: %v", resp.Diagnostics)
+
+	var projectID types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, int64(2), projectID.ValueInt64())
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -27,9 +31,13 @@ type UptraceProvider struct {
 
 // UptraceProviderModel describes the provider data model.
 type UptraceProviderModel struct {
-	Endpoint  types.String `tfsdk:"endpoint"`
-	Token     types.String `tfsdk:"token"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
+	Endpoint          types.String  `tfsdk:"endpoint"`
+	Token             types.String  `tfsdk:"token"`
+	ProjectID         types.Int64   `tfsdk:"project_id"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RequestTimeout    types.String  `tfsdk:"request_timeout"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	RateLimitBurst    types.Int64   `tfsdk:"rate_limit_burst"`
 }
 
 // Metadata returns the provider type name.
@@ -56,6 +64,38 @@ func (p *UptraceProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "The default project ID for Uptrace operations. May also be provided via UPTRACE_PROJECT_ID environment variable.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for failed API requests. Rate-limited (429), server (5xx) and " +
+					"connection errors are retried with exponential backoff; POST requests are only retried on connection errors. " +
+					"Set to 0 to disable retries. Defaults to 3. May also be provided via UPTRACE_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for each API request attempt as a Go duration, e.g. \"30s\" or \"2m\". Time spent waiting for the " +
+					"client-side rate limit does not count against it. Defaults to \"60s\". May also be provided via UPTRACE_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of API requests per second, shared by all resources and data sources of the provider. " +
+					"The rate is lowered automatically while the API responds with 429 Too Many Requests. " +
+					"Set to 0 to disable client-side rate limiting. Defaults to 10. " +
+					"May also be provided via UPTRACE_REQUESTS_PER_SECOND environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Description: "Number of API requests that may be sent at once before requests_per_second applies. Defaults to 10. " +
+					"May also be provided via UPTRACE_RATE_LIMIT_BURST environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -128,15 +168,83 @@ func (p *UptraceProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	// Get retry settings from config or environment
+	maxRetries := int64(client.DefaultMaxRetries)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	} else if envMaxRetries := os.Getenv("UPTRACE_MAX_RETRIES"); envMaxRetries != "" {
+		var err error
+		maxRetries, err = strconv.ParseInt(envMaxRetries, 10, 64)
+		if err != nil || maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Uptrace Max Retries",
+				fmt.Sprintf("The UPTRACE_MAX_RETRIES environment variable value %q is not a non-negative integer.", envMaxRetries),
+			)
+		}
+	}
+
+	requestTimeout := client.DefaultRequestTimeout
+	timeoutValue := os.Getenv("UPTRACE_REQUEST_TIMEOUT")
+	if !config.RequestTimeout.IsNull() {
+		timeoutValue = config.RequestTimeout.ValueString()
+	}
+	if timeoutValue != "" {
+		var err error
+		requestTimeout, err = time.ParseDuration(timeoutValue)
+		if err != nil || requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Uptrace Request Timeout",
+				fmt.Sprintf("The request timeout %q must be a positive duration such as \"30s\" or \"2m\".", timeoutValue),
+			)
+		}
+	}
+
+	// Get rate limit settings from config or environment
+	requestsPerSecond := float64(client.DefaultRequestsPerSecond)
+	if !config.RequestsPerSecond.IsNull() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	} else if envRPS := os.Getenv("UPTRACE_REQUESTS_PER_SECOND"); envRPS != "" {
+		var err error
+		requestsPerSecond, err = strconv.ParseFloat(envRPS, 64)
+		if err != nil || requestsPerSecond < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Uptrace Requests Per Second",
+				fmt.Sprintf("The UPTRACE_REQUESTS_PER_SECOND environment variable value %q is not a non-negative number.", envRPS),
+			)
+		}
+	}
+
+	rateLimitBurst := int64(client.DefaultRateLimitBurst)
+	if !config.RateLimitBurst.IsNull() {
+		rateLimitBurst = config.RateLimitBurst.ValueInt64()
+	} else if envBurst := os.Getenv("UPTRACE_RATE_LIMIT_BURST"); envBurst != "" {
+		var err error
+		rateLimitBurst, err = strconv.ParseInt(envBurst, 10, 64)
+		if err != nil || rateLimitBurst < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit_burst"),
+				"Invalid Uptrace Rate Limit Burst",
+				fmt.Sprintf("The UPTRACE_RATE_LIMIT_BURST environment variable value %q is not a positive integer.", envBurst),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create client
 	uptraceClient, err := client.New(client.Config{
-		Endpoint:  endpoint,
-		Token:     token,
-		ProjectID: projectID,
+		Endpoint:          endpoint,
+		Token:             token,
+		ProjectID:         projectID,
+		MaxRetries:        int(maxRetries),
+		RequestTimeout:    requestTimeout,
+		RequestsPerSecond: requestsPerSecond,
+		RateLimitBurst:    int(rateLimitBurst),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -152,8 +260,12 @@ func (p *UptraceProvider) Configure(ctx context.Context, req provider.ConfigureR
 	resp.ResourceData = uptraceClient
 
 	tflog.Info(ctx, "Configured Uptrace client", map[string]any{
-		"endpoint":   endpoint,
-		"project_id": projectID,
+		"endpoint":            endpoint,
+		"project_id":          projectID,
+		"max_retries":         maxRetries,
+		"request_timeout":     requestTimeout.String(),
+		"requests_per_second": requestsPerSecond,
+		"rate_limit_burst":    rateLimitBurst,
 	})
 }
 
@@ -162,6 +274,11 @@ func (p *UptraceProvider) DataSources(_ context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewMonitorDataSource,
 		NewMonitorsDataSource,
+		NewMonitorAlertsDataSource,
+		NewDashboardDataSource,
+		NewDashboardsDataSource,
+		NewNotificationChannelDataSource,
+		NewNotificationChannelsDataSource,
 	}
 }
 
@@ -170,7 +287,10 @@ func (p *UptraceProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		NewMonitorResource,
 		NewDashboardResource,
+		NewDashboardGridRowResource,
+		NewDashboardGridItemResource,
 		NewNotificationChannelResource,
+		NewMaintenanceWindowResource,
 	}
 }
 
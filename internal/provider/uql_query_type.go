@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/uql"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = UQLQueryType{}
+	_ basetypes.StringValuableWithSemanticEquals = UQLQueryValue{}
+)
+
+// UQLQueryType is a string type for UQL queries that compares values by their
+// canonical form rather than byte for byte.
+type UQLQueryType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t UQLQueryType) String() string {
+	return "UQLQueryType"
+}
+
+// ValueType returns the Value type.
+func (t UQLQueryType) ValueType(_ context.Context) attr.Value {
+	return UQLQueryValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t UQLQueryType) Equal(o attr.Type) bool {
+	other, ok := o.(UQLQueryType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t UQLQueryType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return UQLQueryValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t UQLQueryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// UQLQueryValue is a UQL query value.
+type UQLQueryValue struct {
+	basetypes.StringValue
+}
+
+// NewUQLQueryValue creates a known UQL query value.
+func NewUQLQueryValue(value string) UQLQueryValue {
+	return UQLQueryValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewUQLQueryNull creates a null UQL query value.
+func NewUQLQueryNull() UQLQueryValue {
+	return UQLQueryValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns the attribute type of the value.
+func (v UQLQueryValue) Type(_ context.Context) attr.Type {
+	return UQLQueryType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v UQLQueryValue) Equal(o attr.Value) bool {
+	other, ok := o.(UQLQueryValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both queries have the same canonical form.
+// Whitespace, quoting, alias spacing and the case of keywords are ignored; the order of query
+// parts is not, as moving a where part across a group by part can change the result.
+// Queries that cannot be parsed are compared as plain strings.
+func (v UQLQueryValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UQLQueryValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+
+	oldQuery, err := uql.Normalize(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newQuery, err := uql.Normalize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldQuery == newQuery, diags
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUQLQuery = "avg($cpu) > 90 | where host.name = 'web-1' | group by host.name"
+
+func TestUQLQuerySemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		newQuery string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			newQuery: testUQLQuery,
+			expected: true,
+		},
+		{
+			name:     "whitespace",
+			newQuery: "avg( $cpu )>90  |  where host.name='web-1' |group by host.name",
+			expected: true,
+		},
+		{
+			name:     "keyword case",
+			newQuery: "AVG($cpu) > 90 | WHERE host.name = 'web-1' | GROUP BY host.name",
+			expected: true,
+		},
+		{
+			name:     "quoting",
+			newQuery: "avg($cpu) > 90.0 | where host.name = \"web-1\" | group by host.name",
+			expected: true,
+		},
+		{
+			name:     "clause order",
+			newQuery: "avg($cpu) > 90 | group by host.name | where host.name = 'web-1'",
+			expected: false,
+		},
+		{
+			name:     "changed threshold",
+			newQuery: "avg($cpu) > 95 | where host.name = 'web-1' | group by host.name",
+			expected: false,
+		},
+		{
+			name:     "changed filter value case",
+			newQuery: "avg($cpu) > 90 | where host.name = 'WEB-1' | group by host.name",
+			expected: false,
+		},
+		{
+			name:     "removed group by",
+			newQuery: "avg($cpu) > 90 | where host.name = 'web-1'",
+			expected: false,
+		},
+		{
+			name:     "unparsable",
+			newQuery: "avg($cpu",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldValue := NewUQLQueryValue(testUQLQuery)
+
+			equal, diags := oldValue.StringSemanticEquals(context.Background(), NewUQLQueryValue(tt.newQuery))
+
+			require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expected, equal)
+		})
+	}
+}
+
+func TestUQLQuerySemanticEquals_PartOrder(t *testing.T) {
+	ctx := context.Background()
+	oldValue := NewUQLQueryValue("count($spans) | group by x | where y = 1")
+
+	// Filtering after grouping stays after grouping
+	equal, diags := oldValue.StringSemanticEquals(ctx, NewUQLQueryValue("COUNT($spans)|GROUP BY x|WHERE y=1"))
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.True(t, equal)
+
+	equal, diags = oldValue.StringSemanticEquals(ctx, NewUQLQueryValue("count($spans) | where y = 1 | group by x"))
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	assert.False(t, equal, "Moving where ahead of group by changes which rows are filtered")
+}
+
+func TestUQLQuerySemanticEquals_AliasSpacing(t *testing.T) {
+	oldValue := NewUQLQueryValue("per_min(sum($spans)) as rps,p99($duration)  AS  p99")
+	newValue := NewUQLQueryValue("per_min(sum($spans)) as rps, p99($duration) as p99")
+
+	equal, diags := oldValue.StringSemanticEquals(context.Background(), newValue)
+
+	require.False(t, diags.HasError())
+	assert.True(t, equal)
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/uql"
+)
+
+// uqlQueryValidator validates that every metric alias a UQL query references is declared
+// in the metrics list next to the query. Queries without metrics, such as span and log
+// queries, must not reference any alias. The parser doesn't cover every form Uptrace
+// accepts, so a query it can't parse only gets a warning and its aliases are not checked.
+type uqlQueryValidator struct {
+	withoutMetrics bool
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v uqlQueryValidator) Description(_ context.Context) string {
+	if v.withoutMetrics {
+		return "value must be a UQL query that doesn't reference metric aliases"
+	}
+	return "value must be a UQL query that only references metric aliases declared in metrics"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v uqlQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v uqlQueryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	query, err := uql.Parse(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unrecognized UQL Query",
+			fmt.Sprintf("Could not parse the query: %s. %s", err.Error(), uqlParseFailureDetail),
+		)
+		return
+	}
+
+	if v.withoutMetrics {
+		if aliases := query.Metrics(); len(aliases) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Unexpected Metric Alias",
+				fmt.Sprintf("The query references %s, but this query runs without metrics and can't reference metric aliases.",
+					strings.Join(aliases, ", ")),
+			)
+		}
+		return
+	}
+
+	var metrics types.List
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("metrics"), &metrics)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	declared, ok := declaredMetricAliases(metrics)
+	if !ok {
+		return
+	}
+
+	var undeclared []string
+	for _, name := range query.Metrics() {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Undeclared Metric Alias",
+			fmt.Sprintf("The query references %s, which must be declared as a metric alias in metrics.",
+				strings.Join(undeclared, ", ")),
+		)
+	}
+}
+
+// uqlParseFailureDetail explains what happens to a query the parser can't read.
+const uqlParseFailureDetail = "The query is sent to Uptrace as written, which reports it if it is invalid, " +
+	"and the metric aliases it references are not checked."
+
+// declaredMetricAliases returns the aliases a query can reference, with the dollar sign.
+// Metrics without an alias are referenced by name. It returns false while the metrics
+// are not fully known.
+func declaredMetricAliases(metrics types.List) (map[string]bool, bool) {
+	if metrics.IsNull() || metrics.IsUnknown() {
+		return nil, false
+	}
+
+	declared := make(map[string]bool, len(metrics.Elements()))
+	for _, elem := range metrics.Elements() {
+		metric, ok := elem.(types.Object)
+		if !ok || metric.IsNull() || metric.IsUnknown() {
+			return nil, false
+		}
+
+		attrs := metric.Attributes()
+		alias, _ := attrs["alias"].(types.String)
+		name, _ := attrs["name"].(types.String)
+		if alias.IsUnknown() || name.IsUnknown() {
+			return nil, false
+		}
+
+		if !alias.IsNull() && alias.ValueString() != "" {
+			declared["$"+strings.TrimPrefix(alias.ValueString(), "$")] = true
+		} else if !name.IsNull() {
+			declared["$"+name.ValueString()] = true
+		}
+	}
+	return declared, true
+}
+
+// dashboardYAMLQueryValidator validates the chart queries of a dashboard YAML definition:
+// when the item lists its metrics, every metric alias a query references must be declared
+// there. Queries the parser can't read only get a warning, as in uqlQueryValidator.
+type dashboardYAMLQueryValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v dashboardYAMLQueryValidator) Description(_ context.Context) string {
+	return "queries in the dashboard YAML must be UQL queries that only reference metric aliases declared in metrics"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v dashboardYAMLQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+//
+//nolint:gocritic // Request type defined by Terraform Plugin Framework interface
+func (v dashboardYAMLQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var doc any
+	if err := yaml.Unmarshal([]byte(req.ConfigValue.ValueString()), &doc); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Dashboard YAML",
+			fmt.Sprintf("Could not parse the dashboard YAML: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, problem := range lintDashboardQueries(doc, "") {
+		if problem.warning {
+			resp.Diagnostics.AddAttributeWarning(req.Path, problem.summary, problem.detail)
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, problem.summary, problem.detail)
+	}
+}
+
+// dashboardQueryProblem is a problem found in a query of a dashboard YAML definition.
+type dashboardQueryProblem struct {
+	summary string
+	detail  string
+	warning bool
+}
+
+// lintDashboardQueries checks the queries of every YAML mapping with a "query" key,
+// against the "metrics" key of the same mapping. location is the YAML path of node,
+// such as grid_rows[0].items[1], used in the problem details.
+func lintDashboardQueries(node any, location string) []dashboardQueryProblem {
+	var problems []dashboardQueryProblem
+
+	switch n := node.(type) {
+	case map[any]any:
+		if queries, ok := n["query"]; ok {
+			declared, known := dashboardMetricAliases(n["metrics"])
+			problems = append(problems, lintDashboardItemQueries(queries, joinYAMLPath(location, "query"), declared, known)...)
+		}
+
+		keys := make([]string, 0, len(n))
+		values := make(map[string]any, len(n))
+		for k, value := range n {
+			key := fmt.Sprint(k)
+			if key == "query" {
+				continue
+			}
+			keys = append(keys, key)
+			values[key] = value
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			problems = append(problems, lintDashboardQueries(values[key], joinYAMLPath(location, key))...)
+		}
+	case []any:
+		for i, elem := range n {
+			problems = append(problems, lintDashboardQueries(elem, fmt.Sprintf("%s[%d]", location, i))...)
+		}
+	}
+
+	return problems
+}
+
+// lintDashboardItemQueries checks the value of a "query" key, which is a single query or a list of queries.
+// Metric aliases are only checked when known is true.
+func lintDashboardItemQueries(value any, location string, declared map[string]bool, known bool) []dashboardQueryProblem {
+	var problems []dashboardQueryProblem
+
+	check := func(query, location string) {
+		parsed, err := uql.Parse(query)
+		if err != nil {
+			problems = append(problems, dashboardQueryProblem{
+				summary: "Unrecognized UQL Query",
+				detail:  fmt.Sprintf("Could not parse the query %q at %s: %s. %s", query, location, err.Error(), uqlParseFailureDetail),
+				warning: true,
+			})
+			return
+		}
+		if !known {
+			return
+		}
+
+		var undeclared []string
+		for _, name := range parsed.Metrics() {
+			if !declared[name] {
+				undeclared = append(undeclared, name)
+			}
+		}
+		if len(undeclared) > 0 {
+			problems = append(problems, dashboardQueryProblem{
+				summary: "Undeclared Metric Alias",
+				detail: fmt.Sprintf("The query %q at %s references %s, which must be declared as a metric alias in metrics.",
+					query, location, strings.Join(undeclared, ", ")),
+			})
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		check(v, location)
+	case []any:
+		for i, elem := range v {
+			if query, ok := elem.(string); ok {
+				check(query, fmt.Sprintf("%s[%d]", location, i))
+			}
+		}
+	}
+
+	return problems
+}
+
+// dashboardMetricAliases returns the aliases declared by the "metrics" list of a dashboard
+// YAML item, with the dollar sign. Metrics are written as "<name> as $<alias>", or just
+// "<name>" to be referenced by name. It returns false when the item has no metrics list
+// or a metric is written in another form.
+func dashboardMetricAliases(value any) (map[string]bool, bool) {
+	metrics, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+
+	declared := make(map[string]bool, len(metrics))
+	for _, elem := range metrics {
+		metric, ok := elem.(string)
+		if !ok {
+			return nil, false
+		}
+
+		fields := strings.Fields(metric)
+		switch {
+		case len(fields) == 1:
+			declared["$"+fields[0]] = true
+		case len(fields) == 3 && strings.EqualFold(fields[1], "as"):
+			declared["$"+strings.TrimPrefix(fields[2], "$")] = true
+		default:
+			return nil, false
+		}
+	}
+	return declared, true
+}
+
+// joinYAMLPath appends a key to a YAML path.
+func joinYAMLPath(location, key string) string {
+	if location == "" {
+		return key
+	}
+	return location + "." + key
+}
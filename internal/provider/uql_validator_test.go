@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUQLQueryValidator(t *testing.T) {
+	unknownAlias := types.ListValueMust(types.ObjectType{AttrTypes: metricDefinitionAttrTypes}, []attr.Value{
+		types.ObjectValueMust(metricDefinitionAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue("system_cpu_utilization"),
+			"alias": types.StringUnknown(),
+		}),
+	})
+
+	tests := []struct {
+		name        string
+		query       types.String
+		metrics     types.List
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name:    "declared alias",
+			query:   types.StringValue("avg($cpu) | where host.name = 'web-1'"),
+			metrics: testMetricsList("system_cpu_utilization", "$cpu"),
+		},
+		{
+			name:    "alias declared without dollar sign",
+			query:   types.StringValue("avg($cpu)"),
+			metrics: testMetricsList("system_cpu_utilization", "cpu"),
+		},
+		{
+			name:    "metric without alias referenced by name",
+			query:   types.StringValue("sum($uptrace_tracing_events)"),
+			metrics: testMetricsList("uptrace_tracing_events", ""),
+		},
+		{
+			name:      "undeclared alias",
+			query:     types.StringValue("avg($cpu) / avg($mem)"),
+			metrics:   testMetricsList("system_cpu_utilization", "$cpu"),
+			wantError: "Undeclared Metric Alias",
+		},
+		{
+			name:    "having and limit parts",
+			query:   types.StringValue("avg($cpu) as cpu | group by host.name | having cpu > 90 | limit 10"),
+			metrics: testMetricsList("system_cpu_utilization", "$cpu"),
+		},
+		{
+			name:      "undeclared alias in unknown part",
+			query:     types.StringValue("avg($cpu) | having avg($mem) > 90"),
+			metrics:   testMetricsList("system_cpu_utilization", "$cpu"),
+			wantError: "Undeclared Metric Alias",
+		},
+		{
+			name:        "unparsed query skips the alias check",
+			query:       types.StringValue("avg($mem"),
+			metrics:     testMetricsList("system_cpu_utilization", "$cpu"),
+			wantWarning: "Unrecognized UQL Query",
+		},
+		{
+			name:    "brace expansion",
+			query:   types.StringValue("{p50,p90,p99}($cpu)"),
+			metrics: testMetricsList("system_cpu_utilization", "$cpu"),
+		},
+		{
+			name:    "not exists function",
+			query:   types.StringValue("max($cpu) | where not exists(host.name)"),
+			metrics: testMetricsList("system_cpu_utilization", "$cpu"),
+		},
+		{
+			name:    "unknown alias",
+			query:   types.StringValue("avg($mem)"),
+			metrics: unknownAlias,
+		},
+		{
+			name:    "unknown query",
+			query:   types.StringUnknown(),
+			metrics: testMetricsList("system_cpu_utilization", "$cpu"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := testMetricParams(nil, nil).Attributes()
+			params["metrics"] = tt.metrics
+			config := newMonitorConfig(t, map[string]attr.Value{
+				"metric_params": types.ObjectValueMust(metricParamsAttrTypes, params),
+			})
+
+			req := validator.StringRequest{
+				Path:        path.Root("metric_params").AtName("query"),
+				ConfigValue: tt.query,
+				Config:      config,
+			}
+			resp := &validator.StringResponse{}
+
+			uqlQueryValidator{}.ValidateString(context.Background(), req, resp)
+
+			if tt.wantWarning != "" {
+				assert.False(t, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+				if assert.Len(t, resp.Diagnostics.Warnings(), 1) {
+					assert.Equal(t, tt.wantWarning, resp.Diagnostics.Warnings()[0].Summary())
+				}
+				return
+			}
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestUQLQueryValidator_LegacyParams(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	attribute, diags := schemaResp.Schema.AttributeAtPath(ctx, path.Root("params").AtName("query"))
+	require.False(t, diags.HasError(), "Unexpected diagnostics: %v", diags)
+	stringAttribute, ok := attribute.(schema.StringAttribute)
+	require.True(t, ok)
+	assert.Contains(t, stringAttribute.Validators, uqlQueryValidator{})
+
+	config := newMonitorConfig(t, map[string]attr.Value{"params": testLegacyParams(nil, nil)})
+	for query, wantError := range map[string]bool{"avg($cpu)": false, "avg($mem)": true} {
+		req := validator.StringRequest{
+			Path:        path.Root("params").AtName("query"),
+			ConfigValue: types.StringValue(query),
+			Config:      config,
+		}
+		resp := &validator.StringResponse{}
+
+		uqlQueryValidator{}.ValidateString(ctx, req, resp)
+		assert.Equal(t, wantError, resp.Diagnostics.HasError(), "%s: %v", query, resp.Diagnostics)
+	}
+}
+
+func TestUQLQueryValidator_WithoutMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       types.String
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name:  "span query",
+			query: types.StringValue("count() | where _status_code = 'error' | group by service_name"),
+		},
+		{
+			name:      "metric alias",
+			query:     types.StringValue("avg($cpu)"),
+			wantError: "Unexpected Metric Alias",
+		},
+		{
+			name:        "unparsed query",
+			query:       types.StringValue("count("),
+			wantWarning: "Unrecognized UQL Query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("span_params").AtName("query"),
+				ConfigValue: tt.query,
+			}
+			resp := &validator.StringResponse{}
+
+			uqlQueryValidator{withoutMetrics: true}.ValidateString(context.Background(), req, resp)
+
+			if tt.wantWarning != "" {
+				assert.False(t, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+				if assert.Len(t, resp.Diagnostics.Warnings(), 1) {
+					assert.Equal(t, tt.wantWarning, resp.Diagnostics.Warnings()[0].Summary())
+				}
+				return
+			}
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "Diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestDashboardYAMLQueryValidator(t *testing.T) {
+	tests := []struct {
+		name         string
+		yaml         string
+		wantErrors   []string
+		wantWarnings []string
+		wantDetail   string
+	}{
+		{
+			name: "declared aliases",
+			yaml: `
+schema: v2
+name: Service Overview
+grid_rows:
+  - title: Traffic
+    items:
+      - title: Request Rate
+        metrics:
+          - http_requests_total as $requests
+          - uptrace_tracing_spans
+        query:
+          - per_min(sum($requests)) as rps | group by host.name | having rps > 1 | limit 10
+          - count($uptrace_tracing_spans)
+`,
+		},
+		{
+			name: "single query string",
+			yaml: `
+grid_rows:
+  - items:
+      - metrics: [system.cpu.utilization as $cpu]
+        query: avg($cpu)
+`,
+		},
+		{
+			name: "undeclared alias",
+			yaml: `
+grid_rows:
+  - items:
+      - metrics: [system.cpu.utilization as $cpu]
+        query: [avg($cpu)]
+      - metrics: [system.memory.usage as $mem]
+        query: [avg($mem), avg($cpu)]
+`,
+			wantErrors: []string{"Undeclared Metric Alias"},
+			wantDetail: "grid_rows[0].items[1].query[1]",
+		},
+		{
+			name: "unparsed query",
+			yaml: `
+grid_rows:
+  - items:
+      - metrics: [system.cpu.utilization as $cpu]
+        query: ["avg($mem"]
+`,
+			wantWarnings: []string{"Unrecognized UQL Query"},
+			wantDetail:   "grid_rows[0].items[0].query[0]",
+		},
+		{
+			name: "brace expansion and exists function",
+			yaml: `
+grid_rows:
+  - items:
+      - metrics: [uptrace_tracing_spans as $spans]
+        query: ["{p50,p90,p99}($spans) | where not exists(http.route)"]
+`,
+		},
+		{
+			name: "aliases not checked without metrics",
+			yaml: `
+items:
+  - query: [avg($cpu)]
+`,
+		},
+		{
+			name:       "invalid YAML",
+			yaml:       "grid_rows: [",
+			wantErrors: []string{"Invalid Dashboard YAML"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("yaml"),
+				ConfigValue: types.StringValue(tt.yaml),
+			}
+			resp := &validator.StringResponse{}
+
+			dashboardYAMLQueryValidator{}.ValidateString(context.Background(), req, resp)
+
+			var errors, warnings []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			for _, d := range resp.Diagnostics.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			assert.Equal(t, tt.wantErrors, errors, "Diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantWarnings, warnings, "Diagnostics: %v", resp.Diagnostics)
+			if tt.wantDetail != "" && len(resp.Diagnostics) > 0 {
+				assert.Contains(t, resp.Diagnostics[0].Detail(), tt.wantDetail)
+			}
+		})
+	}
+}
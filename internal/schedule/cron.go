@@ -0,0 +1,174 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed five-field cron expression: minute, hour, day of month, month and day of week.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny record a "*" day field. When both day fields are restricted,
+	// a time matches if either of them does, as in cron.
+	domAny, dowAny bool
+}
+
+// field describes the allowed values of a cron field.
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day of week 7 is accepted for Sunday, as in most cron implementations.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// macros are the supported shorthands for common schedules.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a cron expression such as "0 22 * * 1-5" or "@daily".
+// Fields accept "*", values, names of months and weekdays, ranges, steps and comma separated lists.
+func ParseCron(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := macros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d", len(fields))
+	}
+
+	var c Cron
+	var err error
+	if c.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = strings.HasPrefix(fields[2], "*")
+	c.dowAny = strings.HasPrefix(fields[4], "*")
+	return &c, nil
+}
+
+// Matches reports whether the minute of t matches the expression, in the location of t.
+func (c *Cron) Matches(t time.Time) bool {
+	if c.minute&(1<<t.Minute()) == 0 || c.hour&(1<<t.Hour()) == 0 || c.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+
+	domMatch := c.dom&(1<<t.Day()) != 0
+	dowMatch := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseField parses a comma separated list of ranges into a bit set of the allowed values.
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rangeBits, err := parseRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= rangeBits
+	}
+	return bits, nil
+}
+
+// parseRange parses "*", "v", "a-b", and any of them followed by "/step".
+func parseRange(value string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(value, "/")
+
+	low, high := f.min, f.max
+	switch {
+	case rangePart == "*":
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if low, err = parseValue(lowPart, f); err != nil {
+			return 0, err
+		}
+		if high, err = parseValue(highPart, f); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid %s range %q: start is after end", f.name, rangePart)
+		}
+	default:
+		var err error
+		if low, err = parseValue(rangePart, f); err != nil {
+			return 0, err
+		}
+		// "v/step" runs from v to the end of the field.
+		if !hasStep {
+			high = low
+		}
+	}
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid %s step %q", f.name, stepPart)
+		}
+	}
+
+	var bits uint64
+	for v := low; v <= high; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+// parseValue parses a number or a name within the bounds of the field.
+func parseValue(value string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, value)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,56 @@
+// Package schedule evaluates maintenance windows: a time range that can repeat on a cron schedule.
+//
+// The first occurrence of a window runs from Start to End. When a recurrence is set, a new
+// occurrence of the same length starts at every minute after Start that matches the cron
+// expression. The expression is evaluated in the location of Start, so a window defined
+// with a "+02:00" offset repeats at that offset.
+package schedule
+
+import (
+	"errors"
+	"time"
+)
+
+// Window is a maintenance window with an optional recurrence.
+type Window struct {
+	Start time.Time
+	End   time.Time
+	// Recurrence repeats the window. Nil means the window occurs once.
+	Recurrence *Cron
+}
+
+// Validate checks that the window ends after it starts.
+func (w Window) Validate() error {
+	if !w.End.After(w.Start) {
+		return errors.New("end time must be after start time")
+	}
+	return nil
+}
+
+// Duration returns the length of each occurrence of the window.
+func (w Window) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// ActiveAt reports whether t falls within an occurrence of the window.
+// Occurrences start at the beginning of a matching minute and exclude their end.
+func (w Window) ActiveAt(t time.Time) bool {
+	if t.Before(w.Start) {
+		return false
+	}
+	if t.Before(w.End) {
+		return true
+	}
+	if w.Recurrence == nil {
+		return false
+	}
+
+	// Look for an occurrence that started less than a window length ago.
+	earliest := t.Add(-w.Duration())
+	for start := t.Truncate(time.Minute); start.After(earliest) && !start.Before(w.Start); start = start.Add(-time.Minute) {
+		if w.Recurrence.Matches(start.In(w.Start.Location())) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/schedule"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func mustCron(t *testing.T, expr string) *schedule.Cron {
+	t.Helper()
+	c, err := schedule.ParseCron(expr)
+	if err != nil {
+		t.Fatalf("ParseCron(%q) failed: %v", expr, err)
+	}
+	return c
+}
+
+func TestCronMatches(t *testing.T) {
+	tests := []struct {
+		expr  string
+		time  string
+		match bool
+	}{
+		{expr: "* * * * *", time: "2026-03-04T05:06:00Z", match: true},
+		{expr: "0 22 * * 1-5", time: "2026-03-04T22:00:00Z", match: true},  // Wednesday
+		{expr: "0 22 * * 1-5", time: "2026-03-07T22:00:00Z", match: false}, // Saturday
+		{expr: "0 22 * * 1-5", time: "2026-03-04T22:01:00Z", match: false},
+		{expr: "*/15 * * * *", time: "2026-03-04T05:45:00Z", match: true},
+		{expr: "*/15 * * * *", time: "2026-03-04T05:50:00Z", match: false},
+		{expr: "30 2 * * sun", time: "2026-03-08T02:30:00Z", match: true},
+		{expr: "30 2 * * 7", time: "2026-03-08T02:30:00Z", match: true},
+		{expr: "0 0 1,15 * *", time: "2026-03-15T00:00:00Z", match: true},
+		{expr: "0 0 * jan-mar *", time: "2026-04-01T00:00:00Z", match: false},
+		{expr: "10/20 * * * *", time: "2026-03-04T05:50:00Z", match: true},
+		// Both day fields restricted: either one matches.
+		{expr: "0 0 1 * mon", time: "2026-03-02T00:00:00Z", match: true},
+		{expr: "0 0 1 * mon", time: "2026-03-01T00:00:00Z", match: true},
+		{expr: "0 0 1 * mon", time: "2026-03-03T00:00:00Z", match: false},
+		{expr: "@daily", time: "2026-03-04T00:00:00Z", match: true},
+		{expr: "@weekly", time: "2026-03-04T00:00:00Z", match: false},
+	}
+
+	for _, tt := range tests {
+		if got := mustCron(t, tt.expr).Matches(mustTime(t, tt.time)); got != tt.match {
+			t.Errorf("%q.Matches(%s) = %v, want %v", tt.expr, tt.time, got, tt.match)
+		}
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	exprs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"abc * * * *",
+		"@never",
+	}
+
+	for _, expr := range exprs {
+		if _, err := schedule.ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) should fail", expr)
+		}
+	}
+}
+
+func TestWindowActiveAt(t *testing.T) {
+	once := schedule.Window{
+		Start: mustTime(t, "2026-03-04T22:00:00Z"),
+		End:   mustTime(t, "2026-03-05T02:00:00Z"),
+	}
+	nightly := schedule.Window{
+		Start:      once.Start,
+		End:        once.End,
+		Recurrence: mustCron(t, "0 22 * * *"),
+	}
+	// 22:00 at a +02:00 offset is 20:00 UTC.
+	offset := schedule.Window{
+		Start:      mustTime(t, "2026-03-04T22:00:00+02:00"),
+		End:        mustTime(t, "2026-03-04T23:00:00+02:00"),
+		Recurrence: mustCron(t, "0 22 * * *"),
+	}
+
+	tests := []struct {
+		name   string
+		window schedule.Window
+		time   string
+		active bool
+	}{
+		{name: "before start", window: once, time: "2026-03-04T21:59:59Z", active: false},
+		{name: "at start", window: once, time: "2026-03-04T22:00:00Z", active: true},
+		{name: "during", window: once, time: "2026-03-05T01:30:00Z", active: true},
+		{name: "at end", window: once, time: "2026-03-05T02:00:00Z", active: false},
+		{name: "after end without recurrence", window: once, time: "2026-03-05T22:30:00Z", active: false},
+		{name: "next occurrence", window: nightly, time: "2026-03-05T22:30:00Z", active: true},
+		{name: "next occurrence past midnight", window: nightly, time: "2026-03-06T01:59:00Z", active: true},
+		{name: "between occurrences", window: nightly, time: "2026-03-06T02:00:00Z", active: false},
+		{name: "recurrence before start", window: nightly, time: "2026-03-03T22:30:00Z", active: false},
+		{name: "recurrence in start offset", window: offset, time: "2026-03-05T20:30:00Z", active: true},
+		{name: "recurrence not in UTC", window: offset, time: "2026-03-05T22:30:00Z", active: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.ActiveAt(mustTime(t, tt.time)); got != tt.active {
+				t.Errorf("ActiveAt(%s) = %v, want %v", tt.time, got, tt.active)
+			}
+		})
+	}
+}
+
+func TestWindowValidate(t *testing.T) {
+	start := mustTime(t, "2026-03-04T22:00:00Z")
+	if err := (schedule.Window{Start: start, End: start.Add(time.Hour)}).Validate(); err != nil {
+		t.Errorf("Validate() failed: %v", err)
+	}
+	if err := (schedule.Window{Start: start, End: start}).Validate(); err == nil {
+		t.Error("Validate() should fail when the window is empty")
+	}
+}
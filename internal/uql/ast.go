@@ -0,0 +1,127 @@
+package uql
+
+// Query is a parsed query: a pipeline of parts separated by "|".
+type Query struct {
+	Parts []Part
+}
+
+// Part is one part of a query pipeline.
+type Part interface {
+	part()
+}
+
+// Columns is a list of aggregations or expressions, such as
+// "per_min(sum($spans)) as rps, p99($duration)".
+type Columns struct {
+	Columns []Column
+}
+
+// Column is an expression with an optional "as" alias.
+type Column struct {
+	Expr  Expr
+	Alias string
+}
+
+// GroupBy is a "group by" part.
+type GroupBy struct {
+	Exprs []Expr
+}
+
+// Where is a "where" part filtering by a condition.
+type Where struct {
+	Cond Expr
+}
+
+// Filter is a search filter part, such as "service.name:api-* span.status_code:error".
+type Filter struct {
+	Terms []FilterTerm
+}
+
+// FilterTerm is an attr:value term of a search filter. Attr is empty for bare
+// words, which include the AND and OR connectives.
+type FilterTerm struct {
+	Attr  string
+	Value string
+}
+
+// Raw is a part the parser does not know, such as "having ..." or "limit 10".
+// It is kept as written so queries using newer clauses still parse.
+type Raw struct {
+	Text string
+}
+
+func (*Columns) part() {}
+func (*GroupBy) part() {}
+func (*Where) part()   {}
+func (*Filter) part()  {}
+func (*Raw) part()     {}
+
+// Expr is an expression.
+type Expr interface {
+	expr()
+}
+
+// MetricRef references a metric by its alias, such as $spans, optionally
+// with attribute filters, such as $spans{_status_code="error"}.
+type MetricRef struct {
+	Name    string
+	Filters []Expr
+}
+
+// Attr references an attribute, such as service.name.
+type Attr struct {
+	Name string
+}
+
+// Number is a numeric literal. Text is kept as written.
+type Number struct {
+	Text string
+}
+
+// String is a string literal.
+type String struct {
+	Value string
+}
+
+// Call is a function call, such as sum($spans) or per_min(...).
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+// ExpandedCall is a brace expansion calling several functions with the same arguments,
+// such as {p50,p90,p99}(_dur_ms), which stands for p50(_dur_ms), p90(_dur_ms) and p99(_dur_ms).
+type ExpandedCall struct {
+	Funcs []string
+	Args  []Expr
+}
+
+// Binary is a binary expression. Op is one of the arithmetic and comparison
+// operators or the lowercase keywords and, or, like, not like, in and not in.
+type Binary struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// Unary is a unary expression. Op is "-", "not", "exists" or "not exists";
+// the exists operators are written after their operand.
+type Unary struct {
+	Op string
+	X  Expr
+}
+
+// List is a parenthesized list of values, such as the right operand of "in".
+type List struct {
+	Elems []Expr
+}
+
+func (*MetricRef) expr()    {}
+func (*Attr) expr()         {}
+func (*Number) expr()       {}
+func (*String) expr()       {}
+func (*Call) expr()         {}
+func (*ExpandedCall) expr() {}
+func (*Binary) expr()       {}
+func (*Unary) expr()        {}
+func (*List) expr()         {}
@@ -0,0 +1,176 @@
+package uql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Operator precedence used when printing, from loosest to tightest binding.
+const (
+	precOr = iota + 1
+	precAnd
+	precNot
+	precComparison
+	precAdditive
+	precMultiplicative
+	precUnary
+	precPrimary
+)
+
+// Normalize parses a query and returns its canonical form, so equivalent queries
+// compare equal as strings. See Query.String for what is normalized.
+func Normalize(query string) (string, error) {
+	q, err := Parse(query)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// String returns the canonical form of the query:
+//
+//   - parts keep their order, as reordering them can change what the query means,
+//     and are separated by " | "; unknown parts are kept as written
+//   - keywords and function names are lowercase, search filter connectives uppercase
+//   - operators, commas and aliases are separated by single spaces
+//   - strings use single quotes and numbers their shortest form
+//   - parentheses are only kept where precedence requires them
+func (q *Query) String() string {
+	formatted := make([]string, len(q.Parts))
+	for i, part := range q.Parts {
+		formatted[i] = formatPart(part)
+	}
+	return strings.Join(formatted, " | ")
+}
+
+func formatPart(part Part) string {
+	switch part := part.(type) {
+	case *Columns:
+		columns := make([]string, len(part.Columns))
+		for i, column := range part.Columns {
+			columns[i] = formatExpr(column.Expr, precOr)
+			if column.Alias != "" {
+				columns[i] += " as " + column.Alias
+			}
+		}
+		return strings.Join(columns, ", ")
+	case *GroupBy:
+		return "group by " + formatExprs(part.Exprs)
+	case *Where:
+		return "where " + formatExpr(part.Cond, precOr)
+	case *Filter:
+		terms := make([]string, len(part.Terms))
+		for i, term := range part.Terms {
+			switch {
+			case term.Attr != "":
+				terms[i] = term.Attr + ":" + term.Value
+			case strings.EqualFold(term.Value, "and"), strings.EqualFold(term.Value, "or"):
+				terms[i] = strings.ToUpper(term.Value)
+			default:
+				terms[i] = term.Value
+			}
+		}
+		return strings.Join(terms, " ")
+	case *Raw:
+		return part.Text
+	default:
+		return ""
+	}
+}
+
+func formatExprs(exprs []Expr) string {
+	formatted := make([]string, len(exprs))
+	for i, expr := range exprs {
+		formatted[i] = formatExpr(expr, precOr)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// formatExpr formats an expression, adding parentheses when it binds looser than minPrec.
+func formatExpr(expr Expr, minPrec int) string {
+	prec := precedence(expr)
+
+	var s string
+	switch expr := expr.(type) {
+	case *MetricRef:
+		s = expr.Name
+		if len(expr.Filters) > 0 {
+			s += "{" + formatExprs(expr.Filters) + "}"
+		}
+	case *Attr:
+		s = expr.Name
+	case *Number:
+		s = formatNumber(expr.Text)
+	case *String:
+		s = "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(expr.Value) + "'"
+	case *Call:
+		s = strings.ToLower(expr.Func) + "(" + formatExprs(expr.Args) + ")"
+	case *ExpandedCall:
+		s = "{" + strings.ToLower(strings.Join(expr.Funcs, ",")) + "}(" + formatExprs(expr.Args) + ")"
+	case *List:
+		s = "(" + formatExprs(expr.Elems) + ")"
+	case *Unary:
+		switch expr.Op {
+		case "-":
+			s = "-" + formatExpr(expr.X, precUnary)
+		case "not":
+			s = "not " + formatExpr(expr.X, precNot)
+		default:
+			// exists and not exists follow their operand.
+			s = formatExpr(expr.X, precAdditive) + " " + expr.Op
+		}
+	case *Binary:
+		switch prec {
+		case precComparison:
+			// Comparisons don't chain, so both operands must bind tighter.
+			s = formatExpr(expr.Left, precAdditive) + " " + expr.Op + " " + formatExpr(expr.Right, precAdditive)
+		default:
+			// Left-associative operators.
+			s = formatExpr(expr.Left, prec) + " " + expr.Op + " " + formatExpr(expr.Right, prec+1)
+		}
+	}
+
+	if prec < minPrec {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// precedence returns how tightly an expression binds.
+func precedence(expr Expr) int {
+	switch expr := expr.(type) {
+	case *Binary:
+		switch expr.Op {
+		case "or":
+			return precOr
+		case "and":
+			return precAnd
+		case "+", "-":
+			return precAdditive
+		case "*", "/", "%":
+			return precMultiplicative
+		default:
+			return precComparison
+		}
+	case *Unary:
+		switch expr.Op {
+		case "-":
+			return precUnary
+		case "not":
+			return precNot
+		default:
+			return precComparison
+		}
+	default:
+		return precPrimary
+	}
+}
+
+// formatNumber returns the shortest form of a number, so 90, 90.0 and 9e1 are printed the same.
+func formatNumber(text string) string {
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return text
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,229 @@
+package uql
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind identifies the kind of a token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenMetric
+	tokenNumber
+	tokenString
+	tokenOperator
+	tokenPunct
+)
+
+// String returns a human readable name of the token kind for error messages.
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of query"
+	case tokenIdent:
+		return "identifier"
+	case tokenMetric:
+		return "metric alias"
+	case tokenNumber:
+		return "number"
+	case tokenString:
+		return "string"
+	case tokenOperator:
+		return "operator"
+	default:
+		return "punctuation"
+	}
+}
+
+// token is a lexical token. Text holds the source text, except for strings,
+// where it holds the unquoted value.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// is reports whether the token is the given operator or punctuation.
+func (t token) is(text string) bool {
+	return (t.kind == tokenOperator || t.kind == tokenPunct) && t.text == text
+}
+
+// isKeyword reports whether the token is the given keyword. Keywords are case-insensitive.
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+// operators lists multi-character operators before their single-character prefixes.
+var operators = []string{"!=", "!~", "<=", ">=", "=", "~", "<", ">", "+", "-", "*", "/", "%"}
+
+// lexer splits a query into tokens on demand, so the parser can switch to raw
+// scanning for search filters.
+type lexer struct {
+	src string
+	pos int
+}
+
+// next returns the next token.
+func (l *lexer) next() (token, error) {
+	l.skipSpace()
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	switch {
+	case isIdentStart(r):
+		return token{kind: tokenIdent, text: l.scanIdent(), pos: start}, nil
+	case r == '$':
+		l.pos++
+		name := l.scanIdent()
+		if name == "" {
+			return token{}, &Error{Pos: start, Msg: "expected metric alias name after \"$\""}
+		}
+		return token{kind: tokenMetric, text: "$" + name, pos: start}, nil
+	case isDigit(r) || r == '.' && l.pos+1 < len(l.src) && isDigit(rune(l.src[l.pos+1])):
+		return token{kind: tokenNumber, text: l.scanNumber(), pos: start}, nil
+	case r == '\'' || r == '"':
+		value, err := l.scanString(byte(r))
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokenString, text: value, pos: start}, nil
+	case strings.ContainsRune("|,(){}:", r):
+		l.pos++
+		return token{kind: tokenPunct, text: string(r), pos: start}, nil
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokenOperator, text: op, pos: start}, nil
+		}
+	}
+	return token{}, &Error{Pos: start, Msg: "unexpected character " + quoteRune(r)}
+}
+
+// scanWord returns the raw text up to the next whitespace or pipe.
+// It is used for the values of search filters, such as api-* or >=500.
+func (l *lexer) scanWord() string {
+	start := l.pos
+	for l.pos < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		if unicode.IsSpace(r) || r == '|' {
+			break
+		}
+		l.pos += size
+	}
+	return l.src[start:l.pos]
+}
+
+// scanRaw returns the text up to the next pipe outside of quotes, without
+// trailing whitespace. It is used for parts the parser does not know.
+func (l *lexer) scanRaw() string {
+	start := l.pos
+	var quote byte
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case quote != 0 && c == '\\' && l.pos+1 < len(l.src):
+			l.pos++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '\'' || c == '"'):
+			quote = c
+		case quote == 0 && c == '|':
+			return strings.TrimRightFunc(l.src[start:l.pos], unicode.IsSpace)
+		}
+		l.pos++
+	}
+	return strings.TrimRightFunc(l.src[start:l.pos], unicode.IsSpace)
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		l.pos += size
+	}
+}
+
+// scanIdent scans an identifier. Dots are allowed inside identifiers,
+// so attribute names such as service.name form a single token.
+func (l *lexer) scanIdent() string {
+	start := l.pos
+	for l.pos < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		if !isIdentPart(r) {
+			break
+		}
+		l.pos += size
+	}
+	return l.src[start:l.pos]
+}
+
+func (l *lexer) scanNumber() string {
+	start := l.pos
+	for l.pos < len(l.src) && (isDigit(rune(l.src[l.pos])) || l.src[l.pos] == '.') {
+		l.pos++
+	}
+	// Exponent, such as 1e9 or 2.5E-3.
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		end := l.pos + 1
+		if end < len(l.src) && (l.src[end] == '+' || l.src[end] == '-') {
+			end++
+		}
+		if end < len(l.src) && isDigit(rune(l.src[end])) {
+			l.pos = end
+			for l.pos < len(l.src) && isDigit(rune(l.src[l.pos])) {
+				l.pos++
+			}
+		}
+	}
+	return l.src[start:l.pos]
+}
+
+// scanString scans a string quoted with quote. A backslash escapes the next character.
+func (l *lexer) scanString(quote byte) (string, error) {
+	start := l.pos
+	l.pos++
+
+	var b strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\\' && l.pos+1 < len(l.src):
+			b.WriteByte(l.src[l.pos+1])
+			l.pos += 2
+		case c == quote:
+			l.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			l.pos++
+		}
+	}
+	return "", &Error{Pos: start, Msg: "unterminated string"}
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func quoteRune(r rune) string {
+	return "\"" + string(r) + "\""
+}
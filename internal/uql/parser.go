@@ -0,0 +1,538 @@
+package uql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// keywords cannot be used as attribute names.
+var keywords = map[string]bool{
+	"where": true, "group": true, "by": true, "as": true,
+	"and": true, "or": true, "not": true, "like": true, "in": true, "exists": true,
+}
+
+// expressionKeywords are the keywords that may follow an expression inside a known part.
+var expressionKeywords = map[string]bool{
+	"as": true, "and": true, "or": true, "not": true, "like": true, "in": true, "exists": true,
+}
+
+// comparisonOperators are the operators that compare two values.
+var comparisonOperators = map[string]bool{
+	"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true, "~": true, "!~": true,
+}
+
+// parser is a recursive descent parser with a single token of lookahead.
+type parser struct {
+	lex lexer
+	tok token
+}
+
+// advance moves to the next token.
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+// peek returns the token after the current one without consuming it.
+func (p *parser) peek() token {
+	lex := p.lex
+	tok, err := lex.next()
+	if err != nil {
+		return token{kind: tokenEOF, pos: lex.pos}
+	}
+	return tok
+}
+
+// expect consumes the given punctuation or fails.
+func (p *parser) expect(text string) error {
+	if !p.tok.is(text) {
+		return p.unexpected("expected \"" + text + "\"")
+	}
+	return p.advance()
+}
+
+// unexpected returns an error describing the current token.
+func (p *parser) unexpected(context string) error {
+	msg := "unexpected " + describe(p.tok)
+	if context != "" {
+		msg += ", " + context
+	}
+	return &Error{Pos: p.tok.pos, Msg: msg}
+}
+
+// describe returns how a token is referred to in error messages.
+func describe(tok token) string {
+	switch tok.kind {
+	case tokenEOF:
+		return tok.kind.String()
+	case tokenString:
+		return tok.kind.String() + " " + strconv.Quote(tok.text)
+	default:
+		return "\"" + tok.text + "\""
+	}
+}
+
+func (p *parser) parseQuery() (*Query, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokenEOF {
+		return nil, &Error{Pos: 0, Msg: "empty query"}
+	}
+
+	query := &Query{}
+	for {
+		part, err := p.parsePart()
+		if err != nil {
+			return nil, err
+		}
+		query.Parts = append(query.Parts, part)
+
+		if p.tok.kind == tokenEOF {
+			return query, nil
+		}
+		if err := p.expect("|"); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parsePart() (Part, error) {
+	switch {
+	case p.tok.kind == tokenEOF || p.tok.is("|"):
+		return nil, p.unexpected("expected a query part")
+	case p.tok.isKeyword("where"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return &Where{Cond: cond}, nil
+	case p.tok.isKeyword("group") && p.peek().isKeyword("by"):
+		return p.parseGroupBy()
+	case p.tok.kind == tokenIdent && strings.HasPrefix(p.lex.src[p.lex.pos:], ":"):
+		// A search filter starts with an attribute immediately followed by a colon.
+		return p.parseFilter()
+	case p.isUnknownClause():
+		return p.parseRaw()
+	default:
+		return p.parseColumns()
+	}
+}
+
+func (p *parser) parseGroupBy() (Part, error) {
+	// Skip "group by".
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	groupBy := &GroupBy{}
+	for {
+		expr, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		groupBy.Exprs = append(groupBy.Exprs, expr)
+
+		if !p.tok.is(",") {
+			return groupBy, nil
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isUnknownClause reports whether the current part starts with a clause keyword the
+// parser does not know, such as "having" or "limit": a word that is not a keyword,
+// directly followed by a word, number, metric or string, which no expression allows.
+func (p *parser) isUnknownClause() bool {
+	if p.tok.kind != tokenIdent || keywords[strings.ToLower(p.tok.text)] {
+		return false
+	}
+
+	next := p.peek()
+	switch next.kind {
+	case tokenIdent:
+		return !expressionKeywords[strings.ToLower(next.text)]
+	case tokenNumber, tokenMetric, tokenString:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRaw keeps an unknown part as written, up to the next pipe outside of quotes.
+func (p *parser) parseRaw() (Part, error) {
+	p.lex.pos = p.tok.pos
+	text := p.lex.scanRaw()
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	return &Raw{Text: text}, nil
+}
+
+// parseFilter scans a search filter as raw words up to the next pipe.
+func (p *parser) parseFilter() (Part, error) {
+	p.lex.pos = p.tok.pos
+
+	filter := &Filter{}
+	for {
+		p.lex.skipSpace()
+		start := p.lex.pos
+		word := p.lex.scanWord()
+		if word == "" {
+			break
+		}
+
+		term := FilterTerm{Value: word}
+		if attr, value, ok := strings.Cut(word, ":"); ok && isAttrName(attr) {
+			if value == "" {
+				return nil, &Error{Pos: start, Msg: "missing value for search filter " + strconv.Quote(attr)}
+			}
+			term = FilterTerm{Attr: attr, Value: value}
+		}
+		filter.Terms = append(filter.Terms, term)
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (p *parser) parseColumns() (Part, error) {
+	columns := &Columns{}
+	for {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		column := Column{Expr: expr}
+
+		if p.tok.isKeyword("as") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.tok.kind != tokenIdent && p.tok.kind != tokenMetric || keywords[strings.ToLower(p.tok.text)] {
+				return nil, p.unexpected("expected a column alias")
+			}
+			column.Alias = p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		columns.Columns = append(columns.Columns, column)
+
+		if !p.tok.is(",") {
+			return columns, nil
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.isKeyword("or") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.isKeyword("and") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if !p.tok.isKeyword("not") {
+		return p.parseComparison()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	x, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return &Unary{Op: "not", X: x}, nil
+}
+
+// parseComparison parses a single, non-associative comparison.
+func (p *parser) parseComparison() (Expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	op := ""
+	switch {
+	case p.tok.kind == tokenOperator && comparisonOperators[p.tok.text]:
+		op = p.tok.text
+	case p.tok.isKeyword("like"), p.tok.isKeyword("in"):
+		op = strings.ToLower(p.tok.text)
+	case p.tok.isKeyword("exists"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return &Unary{Op: "exists", X: left}, nil
+	case p.tok.isKeyword("not"):
+		next := p.peek()
+		switch {
+		case next.isKeyword("exists"):
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			return &Unary{Op: "not exists", X: left}, nil
+		case next.isKeyword("like"), next.isKeyword("in"):
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			op = "not " + strings.ToLower(next.text)
+		default:
+			return left, nil
+		}
+	default:
+		return left, nil
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{Op: op, Left: left, Right: right}, nil
+}
+
+func (p *parser) parseAdditive() (Expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.is("+") || p.tok.is("-") {
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.is("*") || p.tok.is("/") || p.tok.is("%") {
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if !p.tok.is("-") {
+		return p.parsePrimary()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &Unary{Op: "-", X: x}, nil
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == tokenNumber:
+		if _, err := strconv.ParseFloat(tok.text, 64); err != nil {
+			return nil, &Error{Pos: tok.pos, Msg: "invalid number " + strconv.Quote(tok.text)}
+		}
+		return &Number{Text: tok.text}, p.advance()
+	case tok.kind == tokenString:
+		return &String{Value: tok.text}, p.advance()
+	case tok.kind == tokenMetric:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return p.parseMetricRef(tok.text)
+	case tok.kind == tokenIdent && !keywords[strings.ToLower(tok.text)]:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if !p.tok.is("(") {
+			return &Attr{Name: tok.text}, nil
+		}
+		args, err := p.parseList("(", ")")
+		if err != nil {
+			return nil, err
+		}
+		return &Call{Func: tok.text, Args: args}, nil
+	case tok.isKeyword("exists") && p.peek().is("("):
+		// The function form of exists, such as "not exists(attr)".
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		args, err := p.parseList("(", ")")
+		if err != nil {
+			return nil, err
+		}
+		return &Call{Func: tok.text, Args: args}, nil
+	case tok.is("{"):
+		return p.parseExpandedCall()
+	case tok.is("("):
+		elems, err := p.parseList("(", ")")
+		if err != nil {
+			return nil, err
+		}
+		if len(elems) == 1 {
+			return elems[0], nil
+		}
+		return &List{Elems: elems}, nil
+	default:
+		return nil, p.unexpected("expected an expression")
+	}
+}
+
+// parseExpandedCall parses a brace expansion of function names followed by their arguments.
+func (p *parser) parseExpandedCall() (Expr, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	call := &ExpandedCall{}
+	for {
+		if p.tok.kind != tokenIdent || keywords[strings.ToLower(p.tok.text)] {
+			return nil, p.unexpected("expected a function name")
+		}
+		call.Funcs = append(call.Funcs, p.tok.text)
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		if p.tok.is("}") {
+			break
+		}
+		if !p.tok.is(",") {
+			return nil, p.unexpected("expected \",\" or \"}\"")
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	args, err := p.parseList("(", ")")
+	if err != nil {
+		return nil, err
+	}
+	call.Args = args
+	return call, nil
+}
+
+// parseMetricRef parses the optional attribute filters of a metric alias.
+func (p *parser) parseMetricRef(name string) (Expr, error) {
+	ref := &MetricRef{Name: name}
+	if !p.tok.is("{") {
+		return ref, nil
+	}
+
+	filters, err := p.parseList("{", "}")
+	if err != nil {
+		return nil, err
+	}
+	ref.Filters = filters
+	return ref, nil
+}
+
+// parseList parses a comma-separated list of expressions between open and closing punctuation.
+func (p *parser) parseList(open, closing string) ([]Expr, error) {
+	if err := p.expect(open); err != nil {
+		return nil, err
+	}
+
+	var elems []Expr
+	for !p.tok.is(closing) {
+		if len(elems) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, p.unexpected("expected \",\" or \"" + closing + "\"")
+			}
+		}
+		elem, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return elems, p.advance()
+}
+
+// isAttrName reports whether s is a valid attribute name.
+func isAttrName(s string) bool {
+	if s == "" || keywords[strings.ToLower(s)] {
+		return false
+	}
+	for i, r := range s {
+		if i == 0 && !isIdentStart(r) || !isIdentPart(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+// Package uql parses the Uptrace Query Language used by monitor and dashboard queries.
+//
+// A query is a pipeline of parts separated by "|". A part is one of:
+//
+//   - a list of aggregations or expressions with optional aliases, such as
+//     "per_min(sum($spans)) as rps, p99($duration)"
+//   - a "group by" list, such as "group by service.name, host.name"
+//   - a "where" filter, such as "where span.status_code = 'error'"
+//   - a search filter, such as "service.name:api-* span.kind:server"
+//
+// Parts starting with a clause the parser does not know, such as "having ..." or
+// "limit 10", are kept as written, so queries using them still parse.
+//
+// Functions sharing their arguments can be written as a brace expansion, such as
+// "{p50,p90,p99}(_dur_ms)".
+//
+// Metrics are referenced by their alias, such as $spans, optionally with attribute
+// filters, such as $spans{_status_code="error"}. The parser works offline and only
+// checks syntax: it does not know which functions or attributes Uptrace supports.
+package uql
+
+import "fmt"
+
+// Error is a syntax error at a byte offset of the query.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+// Error implements the error interface. Positions are reported 1-based.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos+1)
+}
+
+// Parse parses a query.
+func Parse(query string) (*Query, error) {
+	p := &parser{lex: lexer{src: query}}
+	return p.parseQuery()
+}
+
+// Metrics returns the metric aliases referenced by the query, including the
+// dollar sign, in order of first appearance.
+func (q *Query) Metrics() []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, part := range q.Parts {
+		switch part := part.(type) {
+		case *Columns:
+			for _, column := range part.Columns {
+				walkMetrics(column.Expr, add)
+			}
+		case *GroupBy:
+			for _, expr := range part.Exprs {
+				walkMetrics(expr, add)
+			}
+		case *Where:
+			walkMetrics(part.Cond, add)
+		case *Raw:
+			lexMetrics(part.Text, add)
+		}
+	}
+	return names
+}
+
+// lexMetrics calls fn with the name of every metric in the text of an unknown part.
+// Characters the lexer cannot read are skipped.
+func lexMetrics(text string, fn func(string)) {
+	lex := lexer{src: text}
+	for {
+		tok, err := lex.next()
+		if err != nil {
+			lex.pos++
+			continue
+		}
+		if tok.kind == tokenEOF {
+			return
+		}
+		if tok.kind == tokenMetric {
+			fn(tok.text)
+		}
+	}
+}
+
+// walkMetrics calls fn with the name of every metric referenced by expr.
+func walkMetrics(expr Expr, fn func(string)) {
+	switch expr := expr.(type) {
+	case *MetricRef:
+		fn(expr.Name)
+		for _, filter := range expr.Filters {
+			walkMetrics(filter, fn)
+		}
+	case *Call:
+		for _, arg := range expr.Args {
+			walkMetrics(arg, fn)
+		}
+	case *ExpandedCall:
+		for _, arg := range expr.Args {
+			walkMetrics(arg, fn)
+		}
+	case *Binary:
+		walkMetrics(expr.Left, fn)
+		walkMetrics(expr.Right, fn)
+	case *Unary:
+		walkMetrics(expr.X, fn)
+	case *List:
+		for _, elem := range expr.Elems {
+			walkMetrics(elem, fn)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package uql_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/riccap/terraform-provider-uptrace/internal/uql"
+)
+
+// TestParse_Valid tests queries used by monitors and dashboards.
+func TestParse_Valid(t *testing.T) {
+	queries := []string{
+		"avg($cpu)",
+		"avg($cpu) > 90",
+		"avg(cpu_usage) > 90",
+		"p95($latency) > 2000000000",
+		"per_min(sum($spans))",
+		"per_min(sum($spans)) as rps, p99($duration) as p99",
+		"count($spans{_status_code='error'}) / count($spans) as error_rate",
+		`count($spans{_status_code="error", service.name!="api"})`,
+		"sum($logs) | where span.event_name exists",
+		"group by service_name | count($spans) | p99($spans)",
+		"per_min(sum($spans)) | group by service.name, host.name",
+		"avg($cpu) | where host.name = 'web-1' and (service.name like 'api%' or service.name in ('a', 'b'))",
+		"sum($count) | where span.kind not in ('internal') and error.type not exists",
+		"where not span.status_code = 'error'",
+		"-avg($cpu) * 100 + 1.5e3 % 7",
+		"span.kind:server",
+		"service.name:api-gateway span.kind:server http.status_code:>=500",
+		"service.name:*-pipeline span.status_code:error | per_min(count($spans))",
+		"severity:ERROR AND service:api",
+		"WHERE service_name = 'api' | GROUP BY host.name",
+		"p99($duration) as p99 | group by service.name | having p99 > 100",
+		"per_min(count($spans)) | group by host.name | limit 10",
+		"count($spans) | order by count($spans) desc",
+		"count($spans) | limit 10 | where a = 1",
+		"{p50,p90,p99}(_dur_ms)",
+		"{p50, p99}($duration) | group by service.name",
+		"max($hit) | where not exists(attr)",
+		"count() | where exists(http.route) and span.kind = 'server'",
+	}
+
+	for _, query := range queries {
+		if _, err := uql.Parse(query); err != nil {
+			t.Errorf("Parse(%q) failed: %v", query, err)
+		}
+	}
+}
+
+// TestParse_Invalid tests that syntax errors are reported with their position.
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantMsg string
+		wantPos int
+	}{
+		{query: "", wantMsg: "empty query", wantPos: 0},
+		{query: "   ", wantMsg: "empty query", wantPos: 0},
+		{query: "avg($cpu", wantMsg: `unexpected end of query, expected "," or ")"`, wantPos: 8},
+		{query: "avg($cpu) >", wantMsg: "unexpected end of query, expected an expression", wantPos: 11},
+		{query: "avg($cpu) | | group by host", wantMsg: `unexpected "|", expected a query part`, wantPos: 12},
+		{query: "sum($) ", wantMsg: `expected metric alias name after "$"`, wantPos: 4},
+		{query: "where name = 'api", wantMsg: "unterminated string", wantPos: 13},
+		{query: "rate(requests[5m])", wantMsg: `unexpected character "["`, wantPos: 13},
+		{query: "avg($cpu) as", wantMsg: "unexpected end of query, expected a column alias", wantPos: 12},
+		{query: "avg($cpu) avg($mem)", wantMsg: `unexpected "avg", expected "|"`, wantPos: 10},
+		{query: "service.name: span.kind:server", wantMsg: `missing value for search filter "service.name"`, wantPos: 0},
+		{query: "group by", wantMsg: "unexpected end of query, expected an expression", wantPos: 8},
+		{query: "{p50,}(_dur_ms)", wantMsg: `unexpected "}", expected a function name`, wantPos: 5},
+		{query: "{p50 p99}(_dur_ms)", wantMsg: `unexpected "p99", expected "," or "}"`, wantPos: 5},
+		{query: "{p50,p99}", wantMsg: `unexpected end of query, expected "("`, wantPos: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := uql.Parse(tt.query)
+
+			var parseErr *uql.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Expected *uql.Error, got %v", err)
+			}
+			if parseErr.Msg != tt.wantMsg || parseErr.Pos != tt.wantPos {
+				t.Errorf("Expected %q at %d, got %q at %d", tt.wantMsg, tt.wantPos, parseErr.Msg, parseErr.Pos)
+			}
+		})
+	}
+}
+
+// TestError tests that positions are reported 1-based.
+func TestError(t *testing.T) {
+	_, err := uql.Parse("avg($cpu) >")
+	if err == nil || !strings.HasSuffix(err.Error(), "at position 12") {
+		t.Errorf("Expected error at position 12, got %v", err)
+	}
+}
+
+// TestParse_AST tests the structure of a parsed query.
+func TestParse_AST(t *testing.T) {
+	query, err := uql.Parse("per_min(sum($spans{kind='server'})) as rps | where service.name = 'api' | group by host.name")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := &uql.Query{Parts: []uql.Part{
+		&uql.Columns{Columns: []uql.Column{{
+			Expr: &uql.Call{Func: "per_min", Args: []uql.Expr{
+				&uql.Call{Func: "sum", Args: []uql.Expr{
+					&uql.MetricRef{Name: "$spans", Filters: []uql.Expr{
+						&uql.Binary{Op: "=", Left: &uql.Attr{Name: "kind"}, Right: &uql.String{Value: "server"}},
+					}},
+				}},
+			}},
+			Alias: "rps",
+		}}},
+		&uql.Where{Cond: &uql.Binary{Op: "=", Left: &uql.Attr{Name: "service.name"}, Right: &uql.String{Value: "api"}}},
+		&uql.GroupBy{Exprs: []uql.Expr{&uql.Attr{Name: "host.name"}}},
+	}}
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("Unexpected AST: %#v", query)
+	}
+}
+
+// TestParse_UnknownPart tests that parts starting with an unknown clause are kept as written.
+func TestParse_UnknownPart(t *testing.T) {
+	query, err := uql.Parse("p99($duration) as p99 | having  p99 > 100 and name = 'a|b' | limit 10 ")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := []uql.Part{
+		&uql.Raw{Text: "having  p99 > 100 and name = 'a|b'"},
+		&uql.Raw{Text: "limit 10"},
+	}
+	if !reflect.DeepEqual(query.Parts[1:], want) {
+		t.Errorf("Unexpected parts: %#v", query.Parts[1:])
+	}
+
+	// Attributes and expressions followed by keyword operators are not unknown clauses.
+	for _, query := range []string{"limit", "where limit = 10", "host.name exists", "limit as l"} {
+		parsed, err := uql.Parse(query)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", query, err)
+		}
+		if _, ok := parsed.Parts[0].(*uql.Raw); ok {
+			t.Errorf("Parse(%q) kept the part as written", query)
+		}
+	}
+}
+
+// TestParse_Expressions tests the structure of brace expansions and the function form of exists.
+func TestParse_Expressions(t *testing.T) {
+	tests := []struct {
+		query string
+		want  uql.Expr
+	}{
+		{
+			query: "{p50,p90,p99}(_dur_ms)",
+			want:  &uql.ExpandedCall{Funcs: []string{"p50", "p90", "p99"}, Args: []uql.Expr{&uql.Attr{Name: "_dur_ms"}}},
+		},
+		{
+			query: "{ P50 , p99 }($duration{kind='server'})",
+			want: &uql.ExpandedCall{Funcs: []string{"P50", "p99"}, Args: []uql.Expr{
+				&uql.MetricRef{Name: "$duration", Filters: []uql.Expr{
+					&uql.Binary{Op: "=", Left: &uql.Attr{Name: "kind"}, Right: &uql.String{Value: "server"}},
+				}},
+			}},
+		},
+		{
+			query: "where not exists(attr)",
+			want:  &uql.Unary{Op: "not", X: &uql.Call{Func: "exists", Args: []uql.Expr{&uql.Attr{Name: "attr"}}}},
+		},
+		{
+			query: "where attr not exists",
+			want:  &uql.Unary{Op: "not exists", X: &uql.Attr{Name: "attr"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := uql.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+
+			var got uql.Expr
+			switch part := query.Parts[0].(type) {
+			case *uql.Columns:
+				got = part.Columns[0].Expr
+			case *uql.Where:
+				got = part.Cond
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unexpected AST: %#v", got)
+			}
+		})
+	}
+}
+
+// TestParse_Precedence tests operator precedence and keyword operators.
+func TestParse_Precedence(t *testing.T) {
+	query, err := uql.Parse("where a = 1 or b = 2 and c not like 'x%'")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := &uql.Where{Cond: &uql.Binary{
+		Op:   "or",
+		Left: &uql.Binary{Op: "=", Left: &uql.Attr{Name: "a"}, Right: &uql.Number{Text: "1"}},
+		Right: &uql.Binary{
+			Op:    "and",
+			Left:  &uql.Binary{Op: "=", Left: &uql.Attr{Name: "b"}, Right: &uql.Number{Text: "2"}},
+			Right: &uql.Binary{Op: "not like", Left: &uql.Attr{Name: "c"}, Right: &uql.String{Value: "x%"}},
+		},
+	}}
+	if !reflect.DeepEqual(query.Parts[0], want) {
+		t.Errorf("Unexpected AST: %#v", query.Parts[0])
+	}
+}
+
+// TestParse_Filter tests that search filters are split into terms.
+func TestParse_Filter(t *testing.T) {
+	query, err := uql.Parse("service.name:api-* AND http.status_code:>=500 timeout")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := &uql.Filter{Terms: []uql.FilterTerm{
+		{Attr: "service.name", Value: "api-*"},
+		{Value: "AND"},
+		{Attr: "http.status_code", Value: ">=500"},
+		{Value: "timeout"},
+	}}
+	if !reflect.DeepEqual(query.Parts[0], want) {
+		t.Errorf("Unexpected filter: %#v", query.Parts[0])
+	}
+}
+
+// TestQuery_Metrics tests collecting the referenced metric aliases.
+func TestQuery_Metrics(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "avg($cpu) > 90", want: []string{"$cpu"}},
+		{query: "count($errors{kind='server'}) / count($spans) as error_rate | where $spans > 0", want: []string{"$errors", "$spans"}},
+		{query: "avg(cpu_usage)", want: nil},
+		{query: "span.kind:server", want: nil},
+		{query: "p99($duration) as p99 | having max($cpu) > 90 and p99 > 100", want: []string{"$duration", "$cpu"}},
+		{query: "{p50,p99}($duration)", want: []string{"$duration"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := uql.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if got := query.Metrics(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected metrics %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestNormalize tests that equivalent queries have the same canonical form.
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "avg($cpu)>90", want: "avg($cpu) > 90"},
+		{query: "  AVG( $cpu )  >  90.0 ", want: "avg($cpu) > 90"},
+		{query: "per_min(sum($spans))   AS rps,p99($duration) as p99", want: "per_min(sum($spans)) as rps, p99($duration) as p99"},
+		{query: `count($spans{_status_code="error"})`, want: "count($spans{_status_code = 'error'})"},
+		{query: `where name = "it's"`, want: `where name = 'it\'s'`},
+		{query: "GROUP BY host.name | count($spans) | WHERE a = 1", want: "group by host.name | count($spans) | where a = 1"},
+		{query: "group by service_name | count($spans) | p99($spans)", want: "group by service_name | count($spans) | p99($spans)"},
+		{query: "where (a = 1 AND b = 2) or (c = 3)", want: "where a = 1 and b = 2 or c = 3"},
+		{query: "where a = 1 and (b = 2 or c = 3)", want: "where a = 1 and (b = 2 or c = 3)"},
+		{query: "where NOT (a = 1 or b = 2)", want: "where not (a = 1 or b = 2)"},
+		{query: "where a NOT IN ('x','y') and b Not Exists", want: "where a not in ('x', 'y') and b not exists"},
+		{query: "(avg($a) - avg($b)) - (avg($c) - avg($d))", want: "avg($a) - avg($b) - (avg($c) - avg($d))"},
+		{query: "(avg($a) + 1) * 2", want: "(avg($a) + 1) * 2"},
+		{query: "service.name:api   and  span.kind:server", want: "service.name:api AND span.kind:server"},
+		{query: "{P50, p99}( _dur_ms )", want: "{p50,p99}(_dur_ms)"},
+		{query: "max($hit) | where NOT EXISTS(attr)", want: "max($hit) | where not exists(attr)"},
+		{query: "limit 10 | COUNT($spans) |  having  count > 1 ", want: "limit 10 | count($spans) | having  count > 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := uql.Normalize(tt.query)
+			if err != nil {
+				t.Fatalf("Normalize failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+
+			// The canonical form must be stable.
+			again, err := uql.Normalize(got)
+			if err != nil || again != got {
+				t.Errorf("Normalize(%q) = %q, %v", got, again, err)
+			}
+		})
+	}
+}
+
+// TestNormalize_Changes tests that real changes are not normalized away.
+func TestNormalize_Changes(t *testing.T) {
+	pairs := [][2]string{
+		{"avg($cpu) > 90", "avg($cpu) > 95"},
+		{"avg($cpu) > 90", "avg($cpu) >= 90"},
+		{"where host.name = 'web-1'", "where host.name = 'WEB-1'"},
+		{"where Host.Name = 'web-1'", "where host.name = 'web-1'"},
+		{"count($spans) | p99($spans)", "p99($spans) | count($spans)"},
+		{"group by a, b | count($spans)", "group by b, a | count($spans)"},
+		{"(avg($a) + 1) * 2", "avg($a) + 1 * 2"},
+		{"avg($cpu) as cpu", "avg($cpu)"},
+		{"count($spans) | limit 10", "count($spans) | limit 20"},
+		{"count($spans) | group by x | where y = 1", "count($spans) | where y = 1 | group by x"},
+		{"count($spans) | limit 10 | where a = 1", "count($spans) | where a = 1 | limit 10"},
+	}
+
+	for _, pair := range pairs {
+		a, errA := uql.Normalize(pair[0])
+		b, errB := uql.Normalize(pair[1])
+		if errA != nil || errB != nil {
+			t.Fatalf("Normalize failed: %v, %v", errA, errB)
+		}
+		if a == b {
+			t.Errorf("Expected %q and %q to differ, both normalized to %q", pair[0], pair[1], a)
+		}
+	}
+}
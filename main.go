@@ -3,10 +3,16 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/riccap/terraform-provider-uptrace/internal/client"
+	"github.com/riccap/terraform-provider-uptrace/internal/export"
 	"github.com/riccap/terraform-provider-uptrace/internal/provider"
 )
 
@@ -14,6 +20,13 @@ import (
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -29,3 +42,60 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runExport writes the monitors, dashboards and notification channels of a project
+// as Terraform configuration with import blocks. Connection settings default to
+// the environment variables read by the provider.
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [flags]\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+
+	endpoint := flags.String("endpoint", os.Getenv("UPTRACE_ENDPOINT"), "Uptrace API endpoint (defaults to UPTRACE_ENDPOINT)")
+	projectID := flags.String("project-id", os.Getenv("UPTRACE_PROJECT_ID"), "project to export (defaults to UPTRACE_PROJECT_ID)")
+	dir := flags.String("out", "uptrace-export", "directory to write the configuration to")
+	setProjectID := flags.Bool("set-project-id", false, "set project_id on every resource and prefix import IDs with the project ID")
+	writeSecrets := flags.Bool("write-secrets", false, "write the secrets read from Uptrace in plaintext to secrets.auto.tfvars")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	id, err := strconv.ParseInt(*projectID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid project ID %q: set -project-id or UPTRACE_PROJECT_ID", *projectID)
+	}
+
+	// The token is only read from the environment so it doesn't show up in the process list.
+	apiClient, err := client.New(client.Config{
+		Endpoint:          *endpoint,
+		Token:             os.Getenv("UPTRACE_TOKEN"),
+		ProjectID:         id,
+		MaxRetries:        client.DefaultMaxRetries,
+		RequestTimeout:    client.DefaultRequestTimeout,
+		RequestsPerSecond: client.DefaultRequestsPerSecond,
+		RateLimitBurst:    client.DefaultRateLimitBurst,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Uptrace client: %w", err)
+	}
+
+	result, err := export.Run(ctx, apiClient, export.Options{Dir: *dir, SetProjectID: *setProjectID, WriteSecrets: *writeSecrets})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exported %d monitors, %d dashboards and %d notification channels from project %d to %s",
+		result.Monitors, result.Dashboards, result.NotificationChannels, id, *dir)
+	switch {
+	case result.SecretsFile != "":
+		log.Printf("WARNING: %s holds plaintext secrets read from Uptrace and is loaded by Terraform automatically. "+
+			"It is listed in .gitignore; do not commit or share it.", filepath.Join(*dir, result.SecretsFile))
+	case result.Secrets > 0:
+		log.Printf("Set the %d sensitive variables declared in %s before running terraform plan, "+
+			"or export again with -write-secrets to write their values to secrets.auto.tfvars.",
+			result.Secrets, filepath.Join(*dir, "variables.tf"))
+	}
+	return nil
+}